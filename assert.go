@@ -6,15 +6,16 @@ import (
 	"atomicgo.dev/assert"
 	"errors"
 	"fmt"
-	jd "github.com/josephburnett/jd/lib"
 	"os"
 	"reflect"
+	"regexp"
 	"strings"
 	"time"
 
 	"github.com/pterm/pterm"
 
 	"github.com/chalk-ai/assert/internal"
+	"github.com/chalk-ai/assert/match"
 )
 
 type testMock struct {
@@ -326,6 +327,35 @@ func NotEqualValues(t testRunner, expected any, actual any, msg ...any) {
 	}
 }
 
+// Matches asserts that a value is matched by a matcher from the match package.
+// The failure message explains which matcher failed and why.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.Matches(t, user, match.AllOf(
+//		match.HasField("Name", "John"),
+//		match.HasField("CreatedAt", match.WithinDuration(time.Now(), time.Minute)),
+//	))
+func Matches(t testRunner, actual any, matcher match.Matcher, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	if ok, reason := matcher.Match(actual); !ok {
+		internal.Fail(t, "A value !!does not match!! the matcher.", internal.Objects{
+			internal.NewObjectsSingleNamed("Actual", actual)[0],
+			{
+				Name:      "Reason",
+				NameStyle: pterm.NewStyle(pterm.FgYellow),
+				Data:      reason + "\n",
+				Raw:       true,
+			},
+		}, msg...)
+	}
+}
+
 // True asserts that an expression or object resolves to true.
 //
 // When using a custom message, the same formatting as with fmt.Sprintf() is used.
@@ -403,6 +433,7 @@ func NotImplements(t testRunner, interfaceObject, object any, msg ...any) {
 }
 
 // Contains asserts that a string/list/array/slice/map contains the specified element.
+// Maps are checked for a key with the value of element.
 //
 // When using a custom message, the same formatting as with fmt.Sprintf() is used.
 //
@@ -411,12 +442,13 @@ func NotImplements(t testRunner, interfaceObject, object any, msg ...any) {
 //	assert.AssertContains(t, []int{1,2,3}, 2)
 //	assert.AssertContains(t, []string{"Hello", "World"}, "World")
 //	assert.AssertContains(t, "Hello, World!", "World")
+//	assert.AssertContains(t, map[string]int{"Hello": 1}, "Hello")
 func Contains(t testRunner, object, element any, msg ...any) {
 	if test, ok := t.(helper); ok {
 		test.Helper()
 	}
 
-	if !assert.Contains(object, element) {
+	if !internal.DoesContain(object, element) {
 		internal.Fail(t, "An object !!does not contain!! the object it should contain.", internal.Objects{
 			internal.NewObjectsSingleNamed("Missing Object", element)[0],
 			internal.NewObjectsSingleNamed("Full Object", object)[0],
@@ -437,7 +469,7 @@ func NotContains(t testRunner, object, element any, msg ...any) {
 		test.Helper()
 	}
 
-	if assert.Contains(object, element) {
+	if internal.DoesContain(object, element) {
 		internal.Fail(t, "An object !!does contain!! an object it should not contain.", internal.Objects{
 			internal.NewObjectsSingleUnknown(object)[0],
 			internal.NewObjectsSingleNamed("Element that should not be in the object", element)[0],
@@ -446,6 +478,7 @@ func NotContains(t testRunner, object, element any, msg ...any) {
 }
 
 // Panics asserts that a function panics.
+// It returns the recovered value, which can be used for further checks.
 //
 // When using a custom message, the same formatting as with fmt.Sprintf() is used.
 //
@@ -455,17 +488,21 @@ func NotContains(t testRunner, object, element any, msg ...any) {
 //		// ...
 //		panic("some panic")
 //	}) // => PASS
-func Panics(t testRunner, f func(), msg ...any) {
+func Panics(t testRunner, f func(), msg ...any) Recovered {
 	if test, ok := t.(helper); ok {
 		test.Helper()
 	}
 
-	if !assert.Panic(f) {
+	recovered := recoverPanic(f)
+	if !recovered.Panicked {
 		internal.Fail(t, "A function that !!should panic!! did not panic.", internal.Objects{}, msg...)
 	}
+
+	return recovered
 }
 
 // NotPanics asserts that a function does not panic.
+// If it panics, the recovered value and the stack trace of the panic are reported.
 //
 // When using a custom message, the same formatting as with fmt.Sprintf() is used.
 //
@@ -479,8 +516,8 @@ func NotPanics(t testRunner, f func(), msg ...any) {
 		test.Helper()
 	}
 
-	if assert.Panic(f) {
-		internal.Fail(t, "A function that !!should not panic!! did panic.", internal.Objects{}, msg...)
+	if recovered := recoverPanic(f); recovered.Panicked {
+		internal.Fail(t, "A function that !!should not panic!! did panic.", recovered.objects(), msg...)
 	}
 }
 
@@ -752,11 +789,14 @@ func ErrorIs(t testRunner, err, target error, msg ...any) {
 	}
 
 	if !errors.Is(err, target) {
-		internal.Fail(t, "Target error !!should be in the error chain!! of err.", internal.NewObjectsExpectedActual(target.Error(), err.Error()), msg...)
+		internal.Fail(t, "Target error !!should be in the error chain!! of err.", internal.Objects{
+			internal.NewErrorChainObject("Target", target),
+			internal.NewErrorChainObject("Error Chain", err),
+		}, msg...)
 	}
 }
 
-// NotErrorIs
+// NotErrorIs asserts that target is not inside the error chain of err.
 //
 // When using a custom message, the same formatting as with fmt.Sprintf() is used.
 //
@@ -772,7 +812,10 @@ func NotErrorIs(t testRunner, err, target error, msg ...any) {
 	}
 
 	if errors.Is(err, target) {
-		internal.Fail(t, "Target error !!should not be in the error chain!! of err.", internal.NewObjectsExpectedActual(target.Error(), err.Error()), msg...)
+		internal.Fail(t, "Target error !!should not be in the error chain!! of err.", internal.Objects{
+			internal.NewErrorChainObject("Target", target),
+			internal.NewErrorChainObject("Error Chain", err),
+		}, msg...)
 	}
 }
 
@@ -858,12 +901,14 @@ func Decreasing(t testRunner, object any, msg ...any) {
 }
 
 // Regexp asserts that a string matches a given regexp.
+// The regexp can be a string or a *regexp.Regexp. An invalid pattern fails the test.
+// If the string does not match, the longest prefix of the pattern that does match is shown.
 //
 // When using a custom message, the same formatting as with fmt.Sprintf() is used.
 //
 // Example:
 //
-//	assert.AssertRegexp(t, "^a.*c$", "abc")
+//	assert.Regexp(t, "^a.*c$", "abc")
 func Regexp(t testRunner, regex any, txt any, msg ...any) {
 	if test, ok := t.(helper); ok {
 		test.Helper()
@@ -873,12 +918,13 @@ func Regexp(t testRunner, regex any, txt any, msg ...any) {
 }
 
 // NotRegexp asserts that a string does not match a given regexp.
+// The regexp can be a string or a *regexp.Regexp. An invalid pattern fails the test.
 //
 // When using a custom message, the same formatting as with fmt.Sprintf() is used.
 //
 // Example:
 //
-//	assert.AssertNotRegexp(t, "ab.*", "Hello, World!")
+//	assert.NotRegexp(t, "ab.*", "Hello, World!")
 func NotRegexp(t testRunner, regex any, txt any, msg ...any) {
 	if test, ok := t.(helper); ok {
 		test.Helper()
@@ -887,6 +933,80 @@ func NotRegexp(t testRunner, regex any, txt any, msg ...any) {
 	internal.AssertRegexpHelper(t, regex, txt, false, msg...)
 }
 
+// RegexpCaptures asserts that a string matches a given regexp and returns the capture groups of the first match.
+// The first element is the first group, not the whole match. Groups that did not participate in the match are empty.
+// The regexp can be a string or a *regexp.Regexp. If the assertion fails, nil is returned.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	groups := assert.RegexpCaptures(t, `^(\w+)@(\w+)\.com$`, "alice@example.com")
+//	assert.Equal(t, []string{"alice", "example"}, groups)
+func RegexpCaptures(t testRunner, regex any, txt string, msg ...any) []string {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	re, match := regexpSubmatch(t, regex, txt, msg...)
+	if re == nil {
+		return nil
+	}
+
+	return match[1:]
+}
+
+// RegexpNamedCaptures asserts that a string matches a given regexp and returns the named capture groups of the first match.
+// Groups that did not participate in the match are contained with an empty value.
+// The regexp can be a string or a *regexp.Regexp. If the assertion fails, nil is returned.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	groups := assert.RegexpNamedCaptures(t, `^(?P<user>\w+)@(?P<domain>[\w.]+)$`, "alice@example.com")
+//	assert.Equal(t, "example.com", groups["domain"])
+func RegexpNamedCaptures(t testRunner, regex any, txt string, msg ...any) map[string]string {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	re, match := regexpSubmatch(t, regex, txt, msg...)
+	if re == nil {
+		return nil
+	}
+
+	groups := map[string]string{}
+	for i, name := range re.SubexpNames() {
+		if name != "" {
+			groups[name] = match[i]
+		}
+	}
+
+	return groups
+}
+
+// regexpSubmatch returns the compiled regexp and the first submatch, or nil, after failing the test.
+func regexpSubmatch(t testRunner, regex any, txt string, msg ...any) (*regexp.Regexp, []string) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	re, err := internal.CompileRegexp(regex)
+	if err != nil {
+		internal.Fail(t, "The regex pattern !!is invalid!!.", internal.NewInvalidRegexpObjects(regex, err), msg...)
+		return nil, nil
+	}
+
+	match := re.FindStringSubmatch(txt)
+	if match == nil {
+		internal.Fail(t, "The regex pattern !!does not match!! the string.", internal.NewRegexpMismatchObjects(re, txt), msg...)
+		return nil, nil
+	}
+
+	return re, match
+}
+
 // FileExists asserts that a file exists.
 //
 // When using a custom message, the same formatting as with fmt.Sprintf() is used.
@@ -999,6 +1119,7 @@ func DirNotEmpty(t testRunner, dir string, msg ...any) {
 
 // SameElements asserts that two slices contains same elements (including pointers).
 // The order is irrelevant.
+// Duplicates are ignored, use ElementsMatch to also compare how often each element occurs.
 //
 // When using a custom message, the same formatting as with fmt.Sprintf() is used.
 //
@@ -1188,33 +1309,6 @@ func FailNow(t testRunner, msg ...any) {
 	t.FailNow()
 }
 
-func JSONEqual(t testRunner, expected string, actual string, msg ...any) {
-	if test, ok := t.(helper); ok {
-		test.Helper()
-	}
-
-	a, _ := jd.ReadJsonString(expected)
-	b, _ := jd.ReadJsonString(actual)
-
-	d := a.Diff(b)
-
-	if len(d) > 0 {
-		internal.Fail(
-			t,
-			"The JSON objects are not equal.",
-			[]internal.Object{
-				{
-					Name:      "Difference",
-					NameStyle: pterm.NewStyle(pterm.FgYellow),
-					Data:      a.Diff(b).Render(),
-					Raw:       true,
-				},
-			},
-			msg...,
-		)
-	}
-}
-
 func HasPrefix(t testRunner, s string, prefix string, msg ...any) {
 	if test, ok := t.(helper); ok {
 		test.Helper()
@@ -16,6 +16,7 @@ import (
 	"github.com/pterm/pterm"
 
 	. "github.com/chalk-ai/assert"
+	"github.com/chalk-ai/assert/match"
 )
 
 type testMock struct {
@@ -492,6 +493,26 @@ func TestAssertNotEqualValues_fails(t *testing.T) {
 	})
 }
 
+func TestAssertMatches(t *testing.T) {
+	s := assertionTestStruct{Name: "John", Age: 34, Meta: assertionTestStructNested{ID: 42}}
+
+	Matches(t, s, match.AllOf(
+		match.HasField("Name", match.MatchesRegexp("^J")),
+		match.HasField("Meta", match.Equal(assertionTestStructNested{ID: 42})),
+	))
+	Matches(t, []int{3, 1, 2}, match.UnorderedElementsAre(1, 2, 3))
+}
+
+func TestAssertMatches_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		Matches(t, assertionTestStruct{Name: "John"}, match.HasField("Name", "Bob"))
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		Matches(t, 5, match.Not(5))
+	})
+}
+
 func TestAssertTrue(t *testing.T) {
 	True(t, true)
 }
@@ -549,6 +570,7 @@ func TestAssertContains(t *testing.T) {
 		{name: "String Slice", obj: []string{"Hello", "World", "!"}, contains: "World"},
 		{name: "Int Slice", obj: []int{1, 2, 3, 4, 5, 6, 7, 8}, contains: 4},
 		{name: "String", obj: "Hello, World!", contains: "World"},
+		{name: "Map", obj: map[string]int{"Hello": 1, "World": 2}, contains: "World"},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -566,6 +588,8 @@ func TestAssertContains_fails(t *testing.T) {
 		{name: "String Slice", obj: []string{"Hello", "World", "!"}, contains: "asdasdasd"},
 		{name: "Int Slice", obj: []int{1, 2, 3, 4, 5, 6, 7, 8}, contains: 1337},
 		{name: "String", obj: "Hello, World!", contains: "asdasdasd"},
+		{name: "Map", obj: map[string]int{"Hello": 1}, contains: "asdasdasd"},
+		{name: "Map with wrong key type", obj: map[string]int{"Hello": 1}, contains: 1},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -1206,6 +1230,58 @@ func TestAssertNotRegexp_fail(t *testing.T) {
 	})
 }
 
+func TestAssertRegexp_invalid_pattern(t *testing.T) {
+	var tm testMock
+	Regexp(&tm, "p([a-z]+ch", "peache")
+
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "is invalid")
+	Contains(t, tm.ErrorMessage, "missing closing )")
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		NotRegexp(t, "[a-", "peache")
+	})
+}
+
+func TestAssertRegexp_longest_matching_prefix(t *testing.T) {
+	var tm testMock
+	Regexp(&tm, `^user-\d+-admin$`, "user-42-guest")
+
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "Longest Matching Prefix")
+	Contains(t, tm.ErrorMessage, `user-[0-9]+-`)
+	Contains(t, tm.ErrorMessage, `user-42-\" at offset 0`)
+}
+
+func TestRegexpCaptures(t *testing.T) {
+	groups := RegexpCaptures(t, `^(\w+)@(\w+)\.com$`, "alice@example.com")
+	Equal(t, []string{"alice", "example"}, groups)
+
+	groups = RegexpCaptures(t, regexp.MustCompile(`a(x)?b`), "ab")
+	Equal(t, []string{""}, groups)
+}
+
+func TestRegexpCaptures_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		Nil(t, RegexpCaptures(t, `^(\d+)$`, "abc"))
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		RegexpCaptures(t, `(`, "abc")
+	})
+}
+
+func TestRegexpNamedCaptures(t *testing.T) {
+	groups := RegexpNamedCaptures(t, `^(?P<user>\w+)@(?P<domain>[\w.]+)$`, "alice@example.com")
+	Equal(t, map[string]string{"user": "alice", "domain": "example.com"}, groups)
+}
+
+func TestRegexpNamedCaptures_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		RegexpNamedCaptures(t, `^(?P<id>\d+)$`, "abc")
+	})
+}
+
 func TestAssertFileExists(t *testing.T) {
 	t.Run("LICENSE", func(t *testing.T) {
 		FileExists(t, "LICENSE")
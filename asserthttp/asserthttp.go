@@ -0,0 +1,188 @@
+// Package asserthttp contains assertions for http.Handlers and http.Responses,
+// and a recording test server that captures the requests it receives.
+package asserthttp
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/chalk-ai/assert"
+	"github.com/chalk-ai/assert/internal"
+)
+
+type testRunner interface {
+	Error(args ...any)
+	FailNow()
+}
+
+type helper interface {
+	Helper()
+}
+
+// Serve sends a request to a handler and returns the recorded response.
+//
+// Example:
+//
+//	resp := asserthttp.Serve(handler, httptest.NewRequest("GET", "/health", nil))
+//	asserthttp.StatusCode(t, resp, http.StatusOK)
+func Serve(handler http.Handler, req *http.Request) *http.Response {
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	return recorder.Result()
+}
+
+// StatusCode asserts that a response has the expected status code.
+// The response body is printed on failure.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	asserthttp.StatusCode(t, resp, http.StatusCreated)
+func StatusCode(t testRunner, resp *http.Response, expected int, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	if resp.StatusCode != expected {
+		body, _ := readBody(resp)
+		internal.Fail(t, fmt.Sprintf("The response !!should have the status %s!!, but has %s.", statusText(expected), statusText(resp.StatusCode)),
+			internal.NewObjectsSingleNamed("Body", body), msg...)
+	}
+}
+
+// HeaderEquals asserts that the header of a response has the expected value.
+// If the header has multiple values, they are joined with ", ".
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	asserthttp.HeaderEquals(t, resp, "Content-Type", "application/json")
+func HeaderEquals(t testRunner, resp *http.Response, key, expected string, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	values, ok := resp.Header[http.CanonicalHeaderKey(key)]
+	if !ok {
+		internal.Fail(t, fmt.Sprintf("The response !!does not have the header %q!!, but should.", key),
+			internal.NewObjectsSingleNamed("Headers", resp.Header), msg...)
+		return
+	}
+
+	if actual := strings.Join(values, ", "); actual != expected {
+		internal.Fail(t, fmt.Sprintf("The header %q !!is not equal!! to the expected value.", key),
+			internal.NewObjectsExpectedActualWithDiff(expected, actual), msg...)
+	}
+}
+
+// BodyJSONEqual asserts that the body of a response is JSON that is equal to the expected JSON.
+// It works like assert.JSONEqual, so assert.JSONOptions can be passed before the custom message.
+// The body can still be read after the assertion.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	asserthttp.BodyJSONEqual(t, resp, `{"status": "ok"}`)
+//	asserthttp.BodyJSONEqual(t, resp, `{"status": "ok"}`, assert.IgnoreJSONPaths("/requestId"))
+func BodyJSONEqual(t testRunner, resp *http.Response, expected string, opts ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	body, err := readBody(resp)
+	if err != nil {
+		internal.Fail(t, "The response body !!could not be read!!.", internal.NewObjectsSingleNamed("Error", err), jsonMessage(opts)...)
+		return
+	}
+
+	assert.JSONEqual(t, expected, body, opts...)
+}
+
+// jsonMessage returns the custom message, which follows the assert.JSONOptions in opts.
+func jsonMessage(opts []any) []any {
+	for i, opt := range opts {
+		if _, ok := opt.(assert.JSONOption); !ok {
+			return opts[i:]
+		}
+	}
+
+	return nil
+}
+
+// BodyContains asserts that the body of a response contains a substring.
+// The body can still be read after the assertion.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	asserthttp.BodyContains(t, resp, "<title>Dashboard</title>")
+func BodyContains(t testRunner, resp *http.Response, substring string, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	body, err := readBody(resp)
+	if err != nil {
+		internal.Fail(t, "The response body !!could not be read!!.", internal.NewObjectsSingleNamed("Error", err), msg...)
+		return
+	}
+
+	if !strings.Contains(body, substring) {
+		internal.Fail(t, "The response body !!does not contain!! the expected substring.", internal.Objects{
+			internal.NewObjectsSingleNamed("Expected Substring", substring)[0],
+			internal.NewObjectsSingleNamed("Body", body)[0],
+		}, msg...)
+	}
+}
+
+// Redirects asserts that a handler answers a request with a redirect to the location.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	asserthttp.Redirects(t, handler, httptest.NewRequest("GET", "/old", nil), "/new")
+func Redirects(t testRunner, handler http.Handler, req *http.Request, location string, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	resp := Serve(handler, req)
+	if resp.StatusCode < 300 || resp.StatusCode > 399 {
+		body, _ := readBody(resp)
+		internal.Fail(t, fmt.Sprintf("The response !!should be a redirect to %q!!, but has the status %s.", location, statusText(resp.StatusCode)),
+			internal.NewObjectsSingleNamed("Body", body), msg...)
+		return
+	}
+
+	if actual := resp.Header.Get("Location"); actual != location {
+		internal.Fail(t, fmt.Sprintf("The response !!redirects to the wrong location!! with the status %s.", statusText(resp.StatusCode)),
+			internal.NewObjectsExpectedActual(location, actual), msg...)
+	}
+}
+
+// readBody reads the body of a response and replaces it, so that it can be read again.
+func readBody(resp *http.Response) (string, error) {
+	if resp.Body == nil {
+		return "", nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	return string(body), err
+}
+
+func statusText(code int) string {
+	return fmt.Sprintf("%d %s", code, http.StatusText(code))
+}
@@ -0,0 +1,158 @@
+package asserthttp_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/chalk-ai/assert"
+	"github.com/chalk-ai/assert/asserthttp"
+	"github.com/chalk-ai/assert/match"
+)
+
+var testHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/health":
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"status": "ok", "version": 2})
+	case "/old":
+		http.Redirect(w, r, "/new", http.StatusMovedPermanently)
+	default:
+		http.NotFound(w, r)
+	}
+})
+
+func get(path string) *http.Response {
+	return asserthttp.Serve(testHandler, httptest.NewRequest(http.MethodGet, path, nil))
+}
+
+func TestStatusCode(t *testing.T) {
+	asserthttp.StatusCode(t, get("/health"), http.StatusOK)
+	asserthttp.StatusCode(t, get("/missing"), http.StatusNotFound)
+}
+
+func TestStatusCode_fails(t *testing.T) {
+	assert.TestFails(t, func(t assert.TestingPackageWithFailFunctions) {
+		asserthttp.StatusCode(t, get("/missing"), http.StatusOK)
+	})
+}
+
+func TestHeaderEquals(t *testing.T) {
+	asserthttp.HeaderEquals(t, get("/health"), "content-type", "application/json")
+}
+
+func TestHeaderEquals_fails(t *testing.T) {
+	assert.TestFails(t, func(t assert.TestingPackageWithFailFunctions) {
+		asserthttp.HeaderEquals(t, get("/health"), "Content-Type", "text/plain")
+	})
+
+	assert.TestFails(t, func(t assert.TestingPackageWithFailFunctions) {
+		asserthttp.HeaderEquals(t, get("/health"), "X-Request-Id", "1")
+	})
+}
+
+func TestBodyJSONEqual(t *testing.T) {
+	resp := get("/health")
+	asserthttp.BodyJSONEqual(t, resp, `{"version": 2, "status": "ok"}`)
+	asserthttp.BodyContains(t, resp, `"status":"ok"`)
+}
+
+func TestBodyJSONEqual_fails(t *testing.T) {
+	assert.TestFails(t, func(t assert.TestingPackageWithFailFunctions) {
+		asserthttp.BodyJSONEqual(t, get("/health"), `{"status": "down", "version": 2}`)
+	})
+}
+
+type failureRecorder struct {
+	message string
+}
+
+func (r *failureRecorder) Error(args ...any) {
+	r.message = fmt.Sprint(args...)
+}
+
+func (r *failureRecorder) FailNow() {}
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("connection reset")
+}
+
+func TestBodyJSONEqual_read_error(t *testing.T) {
+	var recorder failureRecorder
+	resp := &http.Response{Body: io.NopCloser(failingReader{})}
+	asserthttp.BodyJSONEqual(&recorder, resp, `{}`, assert.IgnoreJSONPaths("/id"), "request %d", 7)
+
+	assert.Contains(t, recorder.message, "could not be read")
+	assert.Contains(t, recorder.message, "request 7")
+}
+
+func TestBodyContains_fails(t *testing.T) {
+	assert.TestFails(t, func(t assert.TestingPackageWithFailFunctions) {
+		asserthttp.BodyContains(t, get("/health"), "down")
+	})
+}
+
+func TestRedirects(t *testing.T) {
+	asserthttp.Redirects(t, testHandler, httptest.NewRequest(http.MethodGet, "/old", nil), "/new")
+}
+
+func TestRedirects_fails(t *testing.T) {
+	assert.TestFails(t, func(t assert.TestingPackageWithFailFunctions) {
+		asserthttp.Redirects(t, testHandler, httptest.NewRequest(http.MethodGet, "/old", nil), "/other")
+	})
+
+	assert.TestFails(t, func(t assert.TestingPackageWithFailFunctions) {
+		asserthttp.Redirects(t, testHandler, httptest.NewRequest(http.MethodGet, "/health", nil), "/new")
+	})
+}
+
+func TestServer(t *testing.T) {
+	srv := asserthttp.NewServer(t, testHandler)
+
+	resp, err := http.Post(srv.URL+"/users?active=true", "application/json", strings.NewReader(`{"name":"alice"}`))
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	srv.ReceivedRequest(http.MethodPost, "/users", `{"name":"alice"}`)
+	srv.ReceivedRequest(http.MethodPost, "/users", match.MatchesRegexp(`"name":"a`))
+	srv.ReceivedRequest(http.MethodPost, "/users", nil)
+
+	requests := srv.Requests()
+	assert.Len(t, requests, 1)
+	assert.Equal(t, "active=true", requests[0].Query)
+	assert.Equal(t, "application/json", requests[0].Header.Get("Content-Type"))
+}
+
+func TestServer_fails(t *testing.T) {
+	srv := asserthttp.NewServer(t, nil)
+	resp, err := http.Post(srv.URL+"/users", "application/json", strings.NewReader(`{"name":"bob"}`))
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	assert.TestFails(t, func(mock assert.TestingPackageWithFailFunctions) {
+		recording := asserthttp.NewServer(mock, nil)
+		defer recording.Close()
+
+		resp, err := http.Post(recording.URL+"/users", "application/json", strings.NewReader(`{"name":"bob"}`))
+		assert.NoError(t, err)
+		resp.Body.Close()
+
+		recording.ReceivedRequest(http.MethodPost, "/users", `{"name":"alice"}`)
+	})
+
+	assert.TestFails(t, func(mock assert.TestingPackageWithFailFunctions) {
+		recording := asserthttp.NewServer(mock, nil)
+		defer recording.Close()
+
+		recording.ReceivedRequest(http.MethodGet, "/", nil)
+	})
+}
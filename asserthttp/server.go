@@ -0,0 +1,135 @@
+package asserthttp
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/chalk-ai/assert/internal"
+	"github.com/chalk-ai/assert/match"
+)
+
+// RecordedRequest is a request that was received by a Server.
+type RecordedRequest struct {
+	Method string
+	Path   string
+	Query  string
+	Header http.Header
+	Body   string
+}
+
+// Server is a local test server that records every request it receives.
+type Server struct {
+	*httptest.Server
+
+	t        testRunner
+	mu       sync.Mutex
+	requests []RecordedRequest
+}
+
+type cleaner interface {
+	Cleanup(f func())
+}
+
+// NewServer starts a recording test server that passes every request to the handler.
+// If the handler is nil, every request is answered with 200 OK.
+// The server is closed when the test finishes.
+//
+// Example:
+//
+//	srv := asserthttp.NewServer(t, nil)
+//	client := api.NewClient(srv.URL)
+//	client.CreateUser("alice")
+//	srv.ReceivedRequest("POST", "/users", match.MatchesRegexp(`"name":\s*"alice"`))
+func NewServer(t testRunner, handler http.Handler) *Server {
+	if handler == nil {
+		handler = http.HandlerFunc(func(http.ResponseWriter, *http.Request) {})
+	}
+
+	s := &Server{t: t}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		r.Body.Close()
+
+		s.mu.Lock()
+		s.requests = append(s.requests, RecordedRequest{
+			Method: r.Method,
+			Path:   r.URL.Path,
+			Query:  r.URL.RawQuery,
+			Header: r.Header.Clone(),
+			Body:   string(body),
+		})
+		s.mu.Unlock()
+
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		handler.ServeHTTP(w, r)
+	}))
+
+	if test, ok := t.(cleaner); ok {
+		test.Cleanup(s.Close)
+	}
+
+	return s
+}
+
+// Requests returns every request the server received so far, in order.
+func (s *Server) Requests() []RecordedRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]RecordedRequest(nil), s.requests...)
+}
+
+// ReceivedRequest asserts that the server received a request with the method and path, whose body matches bodyMatcher.
+// The body is matched as a string. If bodyMatcher is nil, every body matches, and if it is not a match.Matcher,
+// the body is compared with match.Equal.
+// On failure, every received request is listed with the reason why it did not match.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	srv.ReceivedRequest("POST", "/users", `{"name":"alice"}`)
+func (s *Server) ReceivedRequest(method, path string, bodyMatcher any, msg ...any) {
+	if test, ok := s.t.(helper); ok {
+		test.Helper()
+	}
+
+	var matcher match.Matcher
+	switch m := bodyMatcher.(type) {
+	case nil:
+		matcher = match.Anything()
+	case match.Matcher:
+		matcher = m
+	default:
+		matcher = match.Equal(m)
+	}
+
+	requests := s.Requests()
+	rows := make([][]string, 0, len(requests))
+	for i, r := range requests {
+		var reason string
+		switch {
+		case r.Method != method:
+			reason = fmt.Sprintf("method is %s", r.Method)
+		case r.Path != path:
+			reason = fmt.Sprintf("path is %s", r.Path)
+		default:
+			ok, explanation := matcher.Match(r.Body)
+			if ok {
+				return
+			}
+			reason = "body: " + explanation
+		}
+		rows = append(rows, []string{fmt.Sprint(i), r.Method, r.Path, reason})
+	}
+
+	objects := internal.Objects{}
+	if len(rows) > 0 {
+		objects = append(objects, internal.NewTableObject("Received Requests", []string{"#", "Method", "Path", "Mismatch"}, rows))
+	}
+	internal.Fail(s.t, fmt.Sprintf("The server !!did not receive a matching %s %s request!! among %d request(s).", method, path, len(requests)), objects, msg...)
+}
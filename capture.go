@@ -1,13 +1,53 @@
 package assert
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 )
 
+// CaptureOption customizes how CaptureStdout, CaptureStderr and CaptureStdoutAndStderr capture output.
+type CaptureOption func(opts *captureOptions)
+
+type captureOptions struct {
+	tee   bool
+	limit int
+}
+
+// TeeCapturedOutput writes the captured output to the original stdout or stderr too, while it is captured.
+//
+// Example:
+//
+//	stdout, err := assert.CaptureStdout(func(w io.Writer) error {
+//		return runServer()
+//	}, assert.TeeCapturedOutput())
+func TeeCapturedOutput() CaptureOption {
+	return func(opts *captureOptions) {
+		opts.tee = true
+	}
+}
+
+// LimitCapturedOutput keeps at most maxBytes of the captured output of every stream.
+// Additional output is still read and teed, but not kept, and a marker with the number of truncated bytes is appended.
+//
+// Example:
+//
+//	stdout, err := assert.CaptureStdout(func(w io.Writer) error {
+//		return printReport()
+//	}, assert.LimitCapturedOutput(1<<20))
+func LimitCapturedOutput(maxBytes int) CaptureOption {
+	return func(opts *captureOptions) {
+		opts.limit = maxBytes
+	}
+}
+
 // CaptureStdout captures everything written to stdout from a specific function.
 // You can use this method in tests, to validate that your functions writes a string to the terminal.
+// os.Stdout is always restored, even if the capture function returns an error or panics.
+// If the capture function returns an error, the output written until then is returned with it.
+// Captures can be nested. The output is read while the function runs, so it can be of any size.
 //
 // Example:
 //
@@ -18,38 +58,19 @@ import (
 //
 //	assert.AssertNoError(t, err)
 //	assert.AssertEqual(t, "Hello, World!", stdout)
-func CaptureStdout(capture func(w io.Writer) error) (string, error) {
-	originalStdout := os.Stdout
-	r, w, err := os.Pipe()
-	if err != nil {
-		return "", fmt.Errorf("could not capture stdout: %w", err)
-	}
-	os.Stdout = w
+func CaptureStdout(capture func(w io.Writer) error, opts ...CaptureOption) (string, error) {
+	out, err := captureOutput("stdout", func(writers []io.Writer) error {
+		return capture(writers[0])
+	}, opts, &os.Stdout)
 
-	err = capture(w)
-	if err != nil {
-		return "", fmt.Errorf("error inside capture function while capturing stdout: %w", err)
-	}
-
-	err = w.Close()
-	if err != nil {
-		return "", fmt.Errorf("could not capture stdout: %w", err)
-	}
-	out, err := io.ReadAll(r)
-	if err != nil {
-		return "", fmt.Errorf("could not capture stdout: %w", err)
-	}
-	os.Stdout = originalStdout
-	err = r.Close()
-	if err != nil {
-		return "", fmt.Errorf("could not capture stdout: %w", err)
-	}
-
-	return string(out), nil
+	return out[0], err
 }
 
 // CaptureStderr captures everything written to stderr from a specific function.
 // You can use this method in tests, to validate that your functions writes a string to the terminal.
+// os.Stderr is always restored, even if the capture function returns an error or panics.
+// If the capture function returns an error, the output written until then is returned with it.
+// Captures can be nested. The output is read while the function runs, so it can be of any size.
 //
 // Example:
 //
@@ -61,38 +82,19 @@ func CaptureStdout(capture func(w io.Writer) error) (string, error) {
 //
 //	assert.AssertNoError(t, err)
 //	assert.AssertEqual(t, "Hello, World!", stderr)
-func CaptureStderr(capture func(w io.Writer) error) (string, error) {
-	originalStderr := os.Stderr
-	r, w, err := os.Pipe()
-	if err != nil {
-		return "", fmt.Errorf("could not capture stderr: %w", err)
-	}
-	os.Stderr = w
+func CaptureStderr(capture func(w io.Writer) error, opts ...CaptureOption) (string, error) {
+	out, err := captureOutput("stderr", func(writers []io.Writer) error {
+		return capture(writers[0])
+	}, opts, &os.Stderr)
 
-	err = capture(w)
-	if err != nil {
-		return "", fmt.Errorf("error inside capture function while capturing stderr: %w", err)
-	}
-
-	err = w.Close()
-	if err != nil {
-		return "", fmt.Errorf("could not capture stderr: %w", err)
-	}
-	out, err := io.ReadAll(r)
-	if err != nil {
-		return "", fmt.Errorf("could not capture stderr: %w", err)
-	}
-	os.Stderr = originalStderr
-	err = r.Close()
-	if err != nil {
-		return "", fmt.Errorf("could not capture stderr: %w", err)
-	}
-
-	return string(out), nil
+	return out[0], err
 }
 
 // CaptureStdoutAndStderr captures everything written to stdout and stderr from a specific function.
 // You can use this method in tests, to validate that your functions writes a string to the terminal.
+// os.Stdout and os.Stderr are always restored, even if the capture function returns an error or panics.
+// If the capture function returns an error, the output written until then is returned with it.
+// Captures can be nested. The output is read while the function runs, so it can be of any size.
 //
 // Example:
 //
@@ -105,60 +107,149 @@ func CaptureStderr(capture func(w io.Writer) error) (string, error) {
 //	assert.AssertNoError(t, err)
 //	assert.AssertEqual(t, "Hello", stdout)
 //	assert.AssertEqual(t, "World", stderr)
-func CaptureStdoutAndStderr(capture func(stdoutWriter, stderrWriter io.Writer) error) (stdout, stderr string, err error) {
-	originalStdout := os.Stdout
-	originalStderr := os.Stderr
+func CaptureStdoutAndStderr(capture func(stdoutWriter, stderrWriter io.Writer) error, opts ...CaptureOption) (stdout, stderr string, err error) {
+	out, err := captureOutput("stdout or stderr", func(writers []io.Writer) error {
+		return capture(writers[0], writers[1])
+	}, opts, &os.Stdout, &os.Stderr)
 
-	stdoutR, stdoutW, err := os.Pipe()
+	return out[0], out[1], err
+}
+
+// capturedStream redirects an *os.File variable like os.Stdout into a pipe, until it is finished.
+// The pipe is drained by a goroutine, so that writers never block on a full pipe buffer.
+type capturedStream struct {
+	target   **os.File
+	original *os.File
+	r, w     *os.File
+	out      *limitedBuffer
+	copyErr  chan error
+	done     bool
+}
+
+func startCapture(target **os.File, options captureOptions) (*capturedStream, error) {
+	r, w, err := os.Pipe()
 	if err != nil {
-		return "", "", fmt.Errorf("could not capture stdout or stderr: %w", err)
+		return nil, err
 	}
 
-	stderrR, stderrW, err := os.Pipe()
-	if err != nil {
-		return "", "", fmt.Errorf("could not capture stdout or stderr: %w", err)
+	stream := &capturedStream{
+		target:   target,
+		original: *target,
+		r:        r,
+		w:        w,
+		out:      &limitedBuffer{limit: options.limit},
+		copyErr:  make(chan error, 1),
 	}
 
-	os.Stdout = stdoutW
-	os.Stderr = stderrW
+	var dst io.Writer = stream.out
+	if options.tee {
+		dst = io.MultiWriter(stream.out, originalFileWriter{stream.original})
+	}
+	go func() {
+		_, err := io.Copy(dst, r)
+		stream.copyErr <- err
+	}()
 
-	err = capture(stdoutW, stderrW)
-	if err != nil {
-		return "", "", fmt.Errorf("error inside capture function while capturing stdout or stderr: %w", err)
+	*target = w
+
+	return stream, nil
+}
+
+// finish restores the original file, closes the pipe and returns everything that was written to it.
+// It is safe to call finish multiple times, only the first call returns the output.
+func (c *capturedStream) finish() (string, error) {
+	if c.done {
+		return "", nil
 	}
+	c.done = true
+	*c.target = c.original
 
-	err = stdoutW.Close()
-	if err != nil {
-		return "", "", fmt.Errorf("could not capture stdout or stderr: %w", err)
+	// Closing the write end lets the goroutine read the remaining output and stop at EOF.
+	closeErr := c.w.Close()
+	copyErr := <-c.copyErr
+
+	return c.out.String(), errors.Join(closeErr, copyErr, c.r.Close())
+}
+
+// originalFileWriter writes to the original file of a capture. Write errors are ignored, so that they do not stop the capture.
+type originalFileWriter struct {
+	f *os.File
+}
+
+func (w originalFileWriter) Write(p []byte) (int, error) {
+	_, _ = w.f.Write(p)
+	return len(p), nil
+}
+
+// limitedBuffer keeps the first limit bytes written to it and counts the rest. A limit of 0 or less keeps everything.
+type limitedBuffer struct {
+	buf       bytes.Buffer
+	limit     int
+	truncated int
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if b.limit > 0 {
+		if keep := b.limit - b.buf.Len(); keep < len(p) {
+			b.truncated += len(p) - max(keep, 0)
+			b.buf.Write(p[:max(keep, 0)])
+			return len(p), nil
+		}
 	}
 
-	err = stderrW.Close()
-	if err != nil {
-		return "", "", fmt.Errorf("could not capture stdout or stderr: %w", err)
+	return b.buf.Write(p)
+}
+
+func (b *limitedBuffer) String() string {
+	if b.truncated == 0 {
+		return b.buf.String()
 	}
 
-	stdoutOut, err := io.ReadAll(stdoutR)
-	if err != nil {
-		return "", "", fmt.Errorf("could not capture stdout or stderr: %w", err)
+	return b.buf.String() + fmt.Sprintf("\n... [%d bytes truncated]\n", b.truncated)
+}
+
+// captureOutput redirects the targets into pipes while capture runs, and returns what was written to each of them.
+// The targets are restored and the pipes are closed in every case, also if capture panics.
+func captureOutput(name string, capture func(writers []io.Writer) error, opts []CaptureOption, targets ...**os.File) ([]string, error) {
+	var options captureOptions
+	for _, opt := range opts {
+		opt(&options)
 	}
 
-	stderrOut, err := io.ReadAll(stderrR)
-	if err != nil {
-		return "", "", fmt.Errorf("could not capture stdout or stderr: %w", err)
+	out := make([]string, len(targets))
+	streams := make([]*capturedStream, 0, len(targets))
+	defer func() {
+		// Only does something, if capture panicked or a pipe could not be created.
+		for i := len(streams) - 1; i >= 0; i-- {
+			_, _ = streams[i].finish()
+		}
+	}()
+
+	for _, target := range targets {
+		stream, err := startCapture(target, options)
+		if err != nil {
+			return out, fmt.Errorf("could not capture %s: %w", name, err)
+		}
+		streams = append(streams, stream)
 	}
 
-	os.Stdout = originalStdout
-	os.Stderr = originalStderr
+	writers := make([]io.Writer, len(streams))
+	for i, stream := range streams {
+		writers[i] = stream.w
+	}
 
-	err = stdoutR.Close()
-	if err != nil {
-		return "", "", fmt.Errorf("could not capture stdout or stderr: %w", err)
+	var errs []error
+	if err := capture(writers); err != nil {
+		errs = append(errs, fmt.Errorf("error inside capture function while capturing %s: %w", name, err))
 	}
 
-	err = stderrR.Close()
-	if err != nil {
-		return "", "", fmt.Errorf("could not capture stdout or stderr: %w", err)
+	for i := len(streams) - 1; i >= 0; i-- {
+		var err error
+		out[i], err = streams[i].finish()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("could not capture %s: %w", name, err))
+		}
 	}
 
-	return string(stdoutOut), string(stderrOut), nil
+	return out, errors.Join(errs...)
 }
@@ -1,10 +1,12 @@
 package assert_test
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"strconv"
+	"strings"
 	"testing"
 
 	. "github.com/chalk-ai/assert"
@@ -71,3 +73,124 @@ func TestCaptureStdoutAndStderr(t *testing.T) {
 	Equal(t, stderr, "World")
 	NoError(t, err)
 }
+
+func TestCaptureStdout_error(t *testing.T) {
+	originalStdout := os.Stdout
+	captureErr := errors.New("capture failed")
+
+	stdout, err := CaptureStdout(func(w io.Writer) error {
+		fmt.Print("partial output")
+		return captureErr
+	})
+
+	ErrorIs(t, err, captureErr)
+	Equal(t, "partial output", stdout)
+	Equal(t, originalStdout, os.Stdout)
+}
+
+func TestCaptureStdoutAndStderr_error(t *testing.T) {
+	originalStdout, originalStderr := os.Stdout, os.Stderr
+	captureErr := errors.New("capture failed")
+
+	stdout, stderr, err := CaptureStdoutAndStderr(func(stdoutWriter, stderrWriter io.Writer) error {
+		fmt.Fprint(os.Stdout, "Hello")
+		fmt.Fprint(os.Stderr, "World")
+		return captureErr
+	})
+
+	ErrorIs(t, err, captureErr)
+	Equal(t, "Hello", stdout)
+	Equal(t, "World", stderr)
+	Equal(t, originalStdout, os.Stdout)
+	Equal(t, originalStderr, os.Stderr)
+}
+
+func TestCaptureStderr_panic(t *testing.T) {
+	originalStderr := os.Stderr
+	var pipe io.Writer
+
+	Panics(t, func() {
+		_, _ = CaptureStderr(func(w io.Writer) error {
+			pipe = w
+			panic("capture panicked")
+		})
+	})
+
+	Equal(t, originalStderr, os.Stderr)
+	_, err := fmt.Fprint(pipe, "closed")
+	Error(t, err)
+}
+
+func TestCaptureStdout_nested(t *testing.T) {
+	originalStdout := os.Stdout
+	var inner string
+
+	outer, err := CaptureStdout(func(w io.Writer) error {
+		fmt.Print("before ")
+
+		var err error
+		inner, err = CaptureStdout(func(w io.Writer) error {
+			fmt.Print("inner")
+			return errors.New("inner failed")
+		})
+		Error(t, err)
+
+		fmt.Print("after")
+		return nil
+	})
+
+	NoError(t, err)
+	Equal(t, "before after", outer)
+	Equal(t, "inner", inner)
+	Equal(t, originalStdout, os.Stdout)
+}
+
+func TestCaptureStdoutAndStderr_large_output(t *testing.T) {
+	large := strings.Repeat("0123456789abcdef", 1<<16)
+
+	stdout, stderr, err := CaptureStdoutAndStderr(func(stdoutWriter, stderrWriter io.Writer) error {
+		fmt.Fprint(os.Stderr, large)
+		fmt.Fprint(os.Stdout, large)
+		return nil
+	})
+
+	NoError(t, err)
+	Equal(t, len(large), len(stdout))
+	Equal(t, len(large), len(stderr))
+}
+
+func TestCaptureStdout_tee(t *testing.T) {
+	var inner string
+
+	outer, err := CaptureStdout(func(w io.Writer) error {
+		var err error
+		inner, err = CaptureStdout(func(w io.Writer) error {
+			fmt.Print("Hello, World!")
+			return nil
+		}, TeeCapturedOutput())
+		return err
+	})
+
+	NoError(t, err)
+	Equal(t, "Hello, World!", inner)
+	Equal(t, "Hello, World!", outer)
+}
+
+func TestCaptureStderr_limit(t *testing.T) {
+	stderr, err := CaptureStderr(func(w io.Writer) error {
+		fmt.Fprint(os.Stderr, "Hello, ")
+		fmt.Fprint(os.Stderr, "World!")
+		return nil
+	}, LimitCapturedOutput(5))
+
+	NoError(t, err)
+	Equal(t, "Hello\n... [8 bytes truncated]\n", stderr)
+
+	stderr, err = CaptureStderr(func(w io.Writer) error {
+		fmt.Fprint(os.Stderr, "Hello")
+		return nil
+	}, LimitCapturedOutput(5))
+
+	NoError(t, err)
+	Equal(t, "Hello", stderr)
+}
@@ -0,0 +1,189 @@
+package assert
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/chalk-ai/assert/internal"
+)
+
+// Receives asserts that a channel receives a value within the timeout, and returns the value.
+// The test fails if the timeout is hit, or if the channel is closed before a value is received.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	event := assert.Receives(t, events, time.Second)
+func Receives[T any](t testRunner, ch <-chan T, timeout time.Duration, msg ...any) T {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case value, ok := <-ch:
+		if !ok {
+			internal.Fail(t, fmt.Sprintf("The channel !!should receive a value within %s!!, but it was closed.", timeout), internal.Objects{}, msg...)
+		}
+		return value
+	case <-timer.C:
+		internal.Fail(t, fmt.Sprintf("The channel !!should receive a value within %s!!, but the timeout was hit.", timeout), internal.Objects{}, msg...)
+		var zero T
+		return zero
+	}
+}
+
+// ReceivesValue asserts that a channel receives the expected value within the timeout.
+// Only the first received value is compared.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.ReceivesValue(t, results, "done", time.Second)
+func ReceivesValue[T any](t testRunner, ch <-chan T, expected T, timeout time.Duration, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case value, ok := <-ch:
+		if !ok {
+			internal.Fail(t, fmt.Sprintf("The channel !!should receive a value within %s!!, but it was closed.", timeout),
+				internal.NewObjectsSingleNamed("Expected", expected), msg...)
+		} else if !internal.IsEqual(expected, value) {
+			internal.Fail(t, "The channel received a value that !!is not equal!! to the expected value.",
+				internal.NewObjectsExpectedActualWithDiff(expected, value), msg...)
+		}
+	case <-timer.C:
+		internal.Fail(t, fmt.Sprintf("The channel !!should receive a value within %s!!, but the timeout was hit.", timeout),
+			internal.NewObjectsSingleNamed("Expected", expected), msg...)
+	}
+}
+
+// NotReceives asserts that a channel does not receive a value and is not closed for the whole duration.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.NotReceives(t, errs, 100*time.Millisecond)
+func NotReceives[T any](t testRunner, ch <-chan T, duration time.Duration, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case value, ok := <-ch:
+		if !ok {
+			internal.Fail(t, fmt.Sprintf("The channel !!should not receive a value within %s!!, but it was closed.", duration), internal.Objects{}, msg...)
+			return
+		}
+		internal.Fail(t, fmt.Sprintf("The channel !!should not receive a value within %s!!, but it did.", duration),
+			internal.NewObjectsSingleNamed("Received", value), msg...)
+	case <-timer.C:
+	}
+}
+
+// Closed asserts that a channel is closed.
+// The check does not block. If the channel holds a buffered value, that value is received and reported.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	worker.Stop()
+//	assert.Closed(t, worker.Done())
+func Closed[T any](t testRunner, ch <-chan T, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	select {
+	case value, ok := <-ch:
+		if ok {
+			internal.Fail(t, "The channel !!should be closed!!, but it received a value.", internal.NewObjectsSingleNamed("Received", value), msg...)
+		}
+	default:
+		internal.Fail(t, "The channel !!should be closed!!, but it is still open.", internal.Objects{}, msg...)
+	}
+}
+
+// Sends asserts that a value can be sent on a channel within the timeout.
+// The test fails if the timeout is hit, or if the channel is closed.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.Sends(t, jobs, job, time.Second)
+func Sends[T any](t testRunner, ch chan<- T, value T, timeout time.Duration, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	switch trySend(ch, value, timeout) {
+	case sendTimeout:
+		internal.Fail(t, fmt.Sprintf("The channel !!should accept a value within %s!!, but the timeout was hit.", timeout),
+			internal.NewObjectsSingleNamed("Value", value), msg...)
+	case sendClosed:
+		internal.Fail(t, fmt.Sprintf("The channel !!should accept a value within %s!!, but it was closed.", timeout),
+			internal.NewObjectsSingleNamed("Value", value), msg...)
+	}
+}
+
+// WaitGroupDone asserts that a sync.WaitGroup is done within the timeout.
+//
+// NOTE: If the timeout is hit, the goroutine that waits for the WaitGroup keeps running until it is done.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.WaitGroupDone(t, &wg, 5*time.Second)
+func WaitGroupDone(t testRunner, wg *sync.WaitGroup, timeout time.Duration, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	if !internal.CompletesIn(timeout, wg.Wait) {
+		internal.Fail(t, fmt.Sprintf("The WaitGroup !!should be done in %s!!, but the timeout was hit.", timeout), internal.Objects{}, msg...)
+	}
+}
+
+type sendResult int
+
+const (
+	sendOK sendResult = iota
+	sendTimeout
+	sendClosed
+)
+
+// trySend sends a value on a channel, reporting a closed channel instead of panicking.
+func trySend[T any](ch chan<- T, value T, timeout time.Duration) (result sendResult) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	defer func() {
+		if recover() != nil {
+			result = sendClosed
+		}
+	}()
+
+	select {
+	case ch <- value:
+		return sendOK
+	case <-timer.C:
+		return sendTimeout
+	}
+}
@@ -0,0 +1,121 @@
+package assert_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/chalk-ai/assert"
+)
+
+func TestReceives(t *testing.T) {
+	ch := make(chan int, 1)
+	ch <- 42
+
+	Equal(t, 42, Receives(t, ch, time.Second))
+}
+
+func TestReceives_fails(t *testing.T) {
+	var tm testMock
+	Receives(&tm, make(chan int), 10*time.Millisecond)
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "timeout was hit")
+
+	tm = testMock{}
+	ch := make(chan int)
+	close(ch)
+	Receives(&tm, ch, time.Second)
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "it was closed")
+}
+
+func TestReceivesValue(t *testing.T) {
+	ch := make(chan string)
+	go func() { ch <- "done" }()
+
+	ReceivesValue(t, ch, "done", time.Second)
+}
+
+func TestReceivesValue_fails(t *testing.T) {
+	ch := make(chan string, 1)
+	ch <- "failed"
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		ReceivesValue(t, ch, "done", time.Second)
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		ReceivesValue(t, ch, "done", 10*time.Millisecond)
+	})
+}
+
+func TestNotReceives(t *testing.T) {
+	NotReceives(t, make(chan int), 10*time.Millisecond)
+}
+
+func TestNotReceives_fails(t *testing.T) {
+	ch := make(chan int, 1)
+	ch <- 1
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		NotReceives(t, ch, time.Second)
+	})
+
+	close(ch)
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		NotReceives(t, ch, time.Second)
+	})
+}
+
+func TestClosed(t *testing.T) {
+	ch := make(chan struct{})
+	close(ch)
+
+	Closed(t, ch)
+}
+
+func TestClosed_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		Closed(t, make(chan int))
+	})
+
+	ch := make(chan int, 1)
+	ch <- 1
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		Closed(t, ch)
+	})
+}
+
+func TestSends(t *testing.T) {
+	Sends(t, make(chan int, 1), 1, time.Second)
+}
+
+func TestSends_fails(t *testing.T) {
+	var tm testMock
+	Sends(&tm, make(chan int), 1, 10*time.Millisecond)
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "timeout was hit")
+
+	tm = testMock{}
+	ch := make(chan int)
+	close(ch)
+	Sends(&tm, ch, 1, time.Second)
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "it was closed")
+}
+
+func TestWaitGroupDone(t *testing.T) {
+	var wg sync.WaitGroup
+	wg.Go(func() { time.Sleep(time.Millisecond) })
+
+	WaitGroupDone(t, &wg, time.Second)
+}
+
+func TestWaitGroupDone_fails(t *testing.T) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	defer wg.Done()
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		WaitGroupDone(t, &wg, 10*time.Millisecond)
+	})
+}
@@ -0,0 +1,240 @@
+package assert
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/chalk-ai/assert/internal"
+)
+
+// ElementsMatch asserts that two slices contain the same elements, in any order.
+// Unlike SameElements, duplicates count: every element has to occur equally often in both slices.
+// Every element with a different count is reported with its counts and its indices in actual.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.ElementsMatch(t, []int{1, 1, 2}, []int{2, 1, 1})
+func ElementsMatch[T any](t testRunner, expected, actual []T, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	// Elements are grouped with internal.IsEqual instead of a map, as interface elements can hold unhashable values.
+	var groups []elementGroup[T]
+	for _, element := range expected {
+		groups = addToElementGroup(groups, element, func(g *elementGroup[T]) { g.expectedCount++ })
+	}
+	for i, element := range actual {
+		groups = addToElementGroup(groups, element, func(g *elementGroup[T]) { g.actualIndices = append(g.actualIndices, i) })
+	}
+
+	var rows [][]string
+	for _, group := range groups {
+		if group.expectedCount != len(group.actualIndices) {
+			rows = append(rows, []string{
+				internal.FormatValue(group.element),
+				fmt.Sprint(group.expectedCount),
+				fmt.Sprint(len(group.actualIndices)),
+				formatIndices(group.actualIndices),
+			})
+		}
+	}
+
+	if len(rows) > 0 {
+		internal.Fail(t, fmt.Sprintf("Two slices !!do not contain the same elements!!, %d element(s) occur a different number of times.", len(rows)), internal.Objects{
+			internal.NewTableObject("Count Differences", []string{"Element", "Expected Count", "Actual Count", "Indices in Actual"}, rows),
+		}, msg...)
+	}
+}
+
+// All asserts that every element of a slice satisfies the predicate.
+// Every element that does not satisfy it is reported with its index.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.All(t, users, func(u User) bool { return u.Active })
+func All[T any](t testRunner, s []T, predicate func(T) bool, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	if failing := filterIndices(s, func(element T) bool { return !predicate(element) }); len(failing) > 0 {
+		internal.Fail(t, fmt.Sprintf("%d of %d element(s) !!do not satisfy the predicate!!, but all should.", len(failing), len(s)), internal.Objects{
+			indexedElementsObject("Failing Elements", s, failing),
+		}, msg...)
+	}
+}
+
+// Any asserts that at least one element of a slice satisfies the predicate.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.Any(t, users, func(u User) bool { return u.Admin })
+func Any[T any](t testRunner, s []T, predicate func(T) bool, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	if len(filterIndices(s, predicate)) == 0 {
+		internal.Fail(t, fmt.Sprintf("None of the %d element(s) !!satisfy the predicate!!, but at least one should.", len(s)),
+			internal.NewObjectsSingleNamed("Slice", s), msg...)
+	}
+}
+
+// None asserts that no element of a slice satisfies the predicate.
+// Every element that satisfies it is reported with its index.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.None(t, users, func(u User) bool { return u.Deleted })
+func None[T any](t testRunner, s []T, predicate func(T) bool, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	if matching := filterIndices(s, predicate); len(matching) > 0 {
+		internal.Fail(t, fmt.Sprintf("%d of %d element(s) !!satisfy the predicate!!, but none should.", len(matching), len(s)), internal.Objects{
+			indexedElementsObject("Matching Elements", s, matching),
+		}, msg...)
+	}
+}
+
+// ExactlyN asserts that exactly n elements of a slice satisfy the predicate.
+// If the count differs, the elements that satisfy it are reported with their indices.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.ExactlyN(t, users, 1, func(u User) bool { return u.Admin })
+func ExactlyN[T any](t testRunner, s []T, n int, predicate func(T) bool, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	if matching := filterIndices(s, predicate); len(matching) != n {
+		internal.Fail(t, fmt.Sprintf("%d element(s) satisfy the predicate, but !!exactly %d should!!.", len(matching), n), internal.Objects{
+			indexedElementsObject("Matching Elements", s, matching),
+		}, msg...)
+	}
+}
+
+// ContainsAll asserts that a slice contains every one of the elements.
+// Every missing element is reported with its index in elements.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.ContainsAll(t, []string{"a", "b", "c"}, []string{"c", "a"})
+func ContainsAll[T any](t testRunner, s []T, elements []T, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	if missing := filterIndices(elements, func(element T) bool { return !containsEqual(s, element) }); len(missing) > 0 {
+		internal.Fail(t, fmt.Sprintf("A slice !!does not contain %d of %d element(s)!!, but should contain all of them.", len(missing), len(elements)), internal.Objects{
+			indexedElementsObject("Missing Elements", elements, missing),
+			internal.NewObjectsSingleNamed("Slice", s)[0],
+		}, msg...)
+	}
+}
+
+// ContainsAny asserts that a slice contains at least one of the elements.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.ContainsAny(t, []string{"a", "b", "c"}, []string{"x", "b"})
+func ContainsAny[T any](t testRunner, s []T, elements []T, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	for _, element := range elements {
+		if containsEqual(s, element) {
+			return
+		}
+	}
+
+	internal.Fail(t, "A slice !!does not contain any!! of the elements, but should contain at least one.", internal.Objects{
+		internal.NewObjectsSingleNamed("Elements", elements)[0],
+		internal.NewObjectsSingleNamed("Slice", s)[0],
+	}, msg...)
+}
+
+// elementGroup is a distinct element of ElementsMatch, with its count in expected and its indices in actual.
+type elementGroup[T any] struct {
+	element       T
+	expectedCount int
+	actualIndices []int
+}
+
+// addToElementGroup updates the group of an element, which is added first, if no group contains an equal element.
+func addToElementGroup[T any](groups []elementGroup[T], element T, update func(g *elementGroup[T])) []elementGroup[T] {
+	for i := range groups {
+		if internal.IsEqual(groups[i].element, element) {
+			update(&groups[i])
+			return groups
+		}
+	}
+
+	groups = append(groups, elementGroup[T]{element: element})
+	update(&groups[len(groups)-1])
+
+	return groups
+}
+
+// containsEqual reports if a slice contains an element, which is equal according to internal.IsEqual.
+func containsEqual[T any](s []T, element T) bool {
+	for _, e := range s {
+		if internal.IsEqual(e, element) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// filterIndices returns the indices of the elements that satisfy the predicate.
+func filterIndices[T any](s []T, predicate func(T) bool) []int {
+	var indices []int
+	for i, element := range s {
+		if predicate(element) {
+			indices = append(indices, i)
+		}
+	}
+
+	return indices
+}
+
+func indexedElementsObject[T any](name string, s []T, indices []int) internal.Object {
+	rows := make([][]string, 0, len(indices))
+	for _, i := range indices {
+		rows = append(rows, []string{fmt.Sprint(i), internal.FormatValue(s[i])})
+	}
+
+	return internal.NewTableObject(name, []string{"Index", "Element"}, rows)
+}
+
+func formatIndices(indices []int) string {
+	if len(indices) == 0 {
+		return "-"
+	}
+
+	formatted := make([]string, 0, len(indices))
+	for _, i := range indices {
+		formatted = append(formatted, fmt.Sprint(i))
+	}
+
+	return strings.Join(formatted, ", ")
+}
@@ -0,0 +1,134 @@
+package assert_test
+
+import (
+	"testing"
+
+	. "github.com/chalk-ai/assert"
+)
+
+func isEven(n int) bool { return n%2 == 0 }
+
+func TestElementsMatch(t *testing.T) {
+	ElementsMatch(t, []int{1, 1, 2}, []int{2, 1, 1})
+	ElementsMatch(t, []string{}, nil)
+}
+
+func TestElementsMatch_fails(t *testing.T) {
+	var tm testMock
+	ElementsMatch(&tm, []int{1, 1, 2}, []int{1, 2, 2})
+
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "2 element(s) occur a different number of times")
+	Contains(t, tm.ErrorMessage, "1, 2")
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		ElementsMatch(t, []string{"a"}, []string{"a", "b"})
+	})
+}
+
+func TestElementsMatch_unhashable(t *testing.T) {
+	ElementsMatch(t,
+		[]any{[]int{1}, map[string]int{"a": 1}, []int{1}, "x"},
+		[]any{"x", []int{1}, []int{1}, map[string]int{"a": 1}},
+	)
+
+	var tm testMock
+	ElementsMatch(&tm, []any{[]int{1}, []int{1}}, []any{[]int{1}, []int{2}})
+
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "2 element(s) occur a different number of times")
+}
+
+func TestElementsMatch_non_comparable(t *testing.T) {
+	type item struct {
+		Tags []string
+	}
+
+	ElementsMatch(t, []item{{Tags: []string{"a"}}, {Tags: nil}}, []item{{Tags: nil}, {Tags: []string{"a"}}})
+	ContainsAll(t, []item{{Tags: []string{"a"}}, {Tags: []string{"b"}}}, []item{{Tags: []string{"b"}}})
+	ContainsAny(t, []item{{Tags: []string{"a"}}}, []item{{Tags: []string{"x"}}, {Tags: []string{"a"}}})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		ElementsMatch(t, []item{{Tags: []string{"a"}}}, []item{{Tags: []string{"b"}}})
+	})
+}
+
+func TestAll(t *testing.T) {
+	All(t, []int{2, 4, 6}, isEven)
+	All(t, []int{}, isEven)
+}
+
+func TestAll_fails(t *testing.T) {
+	var tm testMock
+	All(&tm, []int{2, 3, 4, 5}, isEven)
+
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "2 of 4 element(s)")
+}
+
+func TestAny(t *testing.T) {
+	Any(t, []int{1, 3, 4}, isEven)
+}
+
+func TestAny_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		Any(t, []int{1, 3}, isEven)
+	})
+}
+
+func TestNone(t *testing.T) {
+	None(t, []int{1, 3, 5}, isEven)
+}
+
+func TestNone_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		None(t, []int{1, 2}, isEven)
+	})
+}
+
+func TestExactlyN(t *testing.T) {
+	ExactlyN(t, []int{1, 2, 3, 4}, 2, isEven)
+	ExactlyN(t, []int{1, 3}, 0, isEven)
+}
+
+func TestExactlyN_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		ExactlyN(t, []int{2, 4, 6}, 2, isEven)
+	})
+}
+
+func TestContainsAll(t *testing.T) {
+	ContainsAll(t, []string{"a", "b", "c"}, []string{"c", "a"})
+	ContainsAll(t, []string{"a"}, nil)
+}
+
+func TestContainsAll_fails(t *testing.T) {
+	var tm testMock
+	ContainsAll(&tm, []string{"a", "b"}, []string{"a", "x", "y"})
+
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "2 of 3 element(s)")
+}
+
+func TestContainsAll_unhashable(t *testing.T) {
+	ContainsAll(t, []any{[]int{1}, map[string]int{"a": 1}}, []any{map[string]int{"a": 1}})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		ContainsAll(t, []any{[]int{1}}, []any{[]int{2}})
+	})
+}
+
+func TestContainsAny(t *testing.T) {
+	ContainsAny(t, []string{"a", "b", "c"}, []string{"x", "b"})
+	ContainsAny(t, []any{[]int{1}, "a"}, []any{map[string]int{}, []int{1}})
+}
+
+func TestContainsAny_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		ContainsAny(t, []string{"a", "b"}, []string{"x", "y"})
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		ContainsAny(t, []any{[]int{1}}, []any{[]int{2}})
+	})
+}
@@ -0,0 +1,143 @@
+package assert
+
+import (
+	"github.com/chalk-ai/assert/internal"
+	"github.com/chalk-ai/assert/match"
+)
+
+// EqualOption customizes the comparison of EqualWith.
+type EqualOption func(opts *internal.CompareOptions)
+
+// IgnoreFields skips struct fields when comparing.
+// A plain name like "ID" ignores the field at every depth, a dotted path like "Meta.ID" only the field at that path.
+// Slice indices and map keys are not part of a dotted path, but a full path like "Items[0].ID" can be used too.
+//
+// Example:
+//
+//	assert.EqualWith(t, expected, actual, assert.IgnoreFields("ID", "Meta.CreatedAt"))
+func IgnoreFields(names ...string) EqualOption {
+	return func(opts *internal.CompareOptions) {
+		opts.IgnoredFields = append(opts.IgnoredFields, names...)
+	}
+}
+
+// IgnoreUnexported skips unexported struct fields when comparing.
+//
+// Example:
+//
+//	assert.EqualWith(t, expected, actual, assert.IgnoreUnexported())
+func IgnoreUnexported() EqualOption {
+	return func(opts *internal.CompareOptions) {
+		opts.IgnoreUnexported = true
+	}
+}
+
+// IgnoreOrder compares slices and arrays regardless of the order of their elements.
+// Duplicates are respected, so []int{1, 1, 2} and []int{1, 2, 2} are not equal.
+//
+// Example:
+//
+//	assert.EqualWith(t, []int{1, 2, 3}, []int{3, 1, 2}, assert.IgnoreOrder())
+func IgnoreOrder() EqualOption {
+	return func(opts *internal.CompareOptions) {
+		opts.IgnoreOrder = true
+	}
+}
+
+// EquateEmpty treats nil and empty slices and maps as equal.
+//
+// Example:
+//
+//	assert.EqualWith(t, []int(nil), []int{}, assert.EquateEmpty())
+func EquateEmpty() EqualOption {
+	return func(opts *internal.CompareOptions) {
+		opts.NilEqualsEmpty = true
+	}
+}
+
+// EquateApprox compares floats as equal, if their absolute difference is at most epsilon.
+//
+// Example:
+//
+//	assert.EqualWith(t, 0.3, 0.1+0.2, assert.EquateApprox(1e-9))
+func EquateApprox(epsilon float64) EqualOption {
+	return func(opts *internal.CompareOptions) {
+		opts.FloatEpsilon = epsilon
+	}
+}
+
+// EquateTimes compares time.Time values with time.Time.Equal, which ignores the location and the monotonic clock.
+//
+// Example:
+//
+//	assert.EqualWith(t, now, now.UTC(), assert.EquateTimes())
+func EquateTimes() EqualOption {
+	return func(opts *internal.CompareOptions) {
+		opts.TimeEqual = true
+	}
+}
+
+// MatchField compares a struct field with a matcher instead of its expected value.
+// The field name or path is interpreted like in IgnoreFields.
+//
+// Example:
+//
+//	assert.EqualWith(t, expected, actual, assert.MatchField("CreatedAt", match.WithinDuration(time.Now(), time.Minute)))
+func MatchField(name string, matcher match.Matcher) EqualOption {
+	return func(opts *internal.CompareOptions) {
+		if opts.FieldMatchers == nil {
+			opts.FieldMatchers = make(map[string]internal.Matcher)
+		}
+		opts.FieldMatchers[name] = matcher
+	}
+}
+
+// EqualWith asserts that two objects are equal, using the given options to customize the comparison.
+// Every difference is reported with its path. Ignored fields are not part of the output.
+//
+// The options are followed by an optional custom message, which uses the same formatting as fmt.Sprintf().
+//
+// Example:
+//
+//	assert.EqualWith(t, expected, actual, assert.IgnoreFields("ID"), assert.EquateEmpty())
+//	assert.EqualWith(t, expected, actual, assert.IgnoreOrder(), assert.EquateApprox(0.001), "Custom message")
+func EqualWith(t testRunner, expected any, actual any, opts ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	var compareOpts internal.CompareOptions
+	var msg []any
+	for i, opt := range opts {
+		if o, ok := opt.(EqualOption); ok {
+			o(&compareOpts)
+			continue
+		}
+		msg = opts[i:]
+		break
+	}
+
+	if diffs := internal.Compare(expected, actual, compareOpts); len(diffs) > 0 {
+		internal.Fail(t, "Two objects that !!should be equal!!, are not equal.", internal.NewObjectsDifferences(diffs), msg...)
+	}
+}
+
+// EqualPartial asserts that actual matches the fields which are set in the template.
+// Struct fields of the template with a zero value are skipped, which is useful when values like IDs and timestamps are generated.
+// Nested structs, slices and maps are compared recursively. Maps only have to contain the keys of the template.
+// Every difference is reported with its path.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.EqualPartial(t, User{Name: "John", Roles: []Role{{Name: "admin"}}}, createdUser)
+func EqualPartial(t testRunner, template any, actual any, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	if diffs := internal.Compare(template, actual, internal.CompareOptions{Partial: true}); len(diffs) > 0 {
+		internal.Fail(t, "An object !!does not match!! the fields set in the template.", internal.NewObjectsDifferences(diffs), msg...)
+	}
+}
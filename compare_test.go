@@ -0,0 +1,145 @@
+package assert_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/chalk-ai/assert"
+	"github.com/chalk-ai/assert/match"
+)
+
+type compareTestStruct struct {
+	ID        int
+	Name      string
+	Score     float64
+	Tags      []string
+	Labels    map[string]string
+	CreatedAt time.Time
+	Meta      compareTestStructNested
+	secret    string
+}
+
+type compareTestStructNested struct {
+	ID      int
+	Version int
+}
+
+func TestEqualWith(t *testing.T) {
+	now := time.Now()
+	expected := compareTestStruct{
+		ID:        1,
+		Name:      "John",
+		Score:     0.3,
+		Tags:      []string{"a", "b"},
+		CreatedAt: now,
+		Meta:      compareTestStructNested{ID: 1, Version: 2},
+		secret:    "foo",
+	}
+	actual := compareTestStruct{
+		ID:        2,
+		Name:      "John",
+		Score:     0.1 + 0.2,
+		Tags:      []string{"b", "a"},
+		Labels:    map[string]string{},
+		CreatedAt: now.In(time.FixedZone("test", 3600)),
+		Meta:      compareTestStructNested{ID: 3, Version: 2},
+		secret:    "bar",
+	}
+
+	EqualWith(t, expected, actual,
+		IgnoreFields("ID"),
+		IgnoreUnexported(),
+		IgnoreOrder(),
+		EquateEmpty(),
+		EquateApprox(1e-9),
+		EquateTimes(),
+	)
+}
+
+func TestEqualWith_field_paths(t *testing.T) {
+	expected := compareTestStruct{ID: 1, Meta: compareTestStructNested{ID: 1}}
+	actual := compareTestStruct{ID: 1, Meta: compareTestStructNested{ID: 2}}
+
+	EqualWith(t, expected, actual, IgnoreFields("Meta.ID"))
+	EqualWith(t, expected, actual, MatchField("Meta.ID", match.AnyOf(1, 2)))
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		EqualWith(t, expected, actual, IgnoreFields("Meta.Version"))
+	})
+}
+
+func TestEqualWith_ignore_order_overlapping_matchers(t *testing.T) {
+	EqualWith(t, []any{match.AnyOf(1, 2), 1}, []any{1, 2}, IgnoreOrder())
+	EqualWith(t, []any{match.Anything(), "a", "b"}, []any{"a", "b", "c"}, IgnoreOrder())
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		EqualWith(t, []any{match.AnyOf(1, 2), 1}, []any{2, 2}, IgnoreOrder())
+	})
+}
+
+func TestEqualWith_fails(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected any
+		actual   any
+		opts     []any
+	}{
+		{name: "Different values", expected: compareTestStruct{Name: "John"}, actual: compareTestStruct{Name: "Bob"}},
+		{name: "Nil and empty", expected: []int(nil), actual: []int{}},
+		{name: "Order", expected: []int{1, 2}, actual: []int{2, 1}},
+		{name: "Duplicates", expected: []int{1, 1, 2}, actual: []int{1, 2, 2}, opts: []any{IgnoreOrder()}},
+		{name: "Epsilon", expected: 1.0, actual: 1.1, opts: []any{EquateApprox(0.01)}},
+		{name: "Times", expected: time.Unix(0, 0), actual: time.Unix(0, 0).UTC()},
+		{name: "Matcher", expected: compareTestStruct{}, actual: compareTestStruct{Name: "John"}, opts: []any{MatchField("Name", match.MatchesRegexp("^B"))}},
+		{name: "With message", expected: 1, actual: 2, opts: []any{EquateApprox(0.1), "custom %s", "message"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			TestFails(t, func(t TestingPackageWithFailFunctions) {
+				EqualWith(t, test.expected, test.actual, test.opts...)
+			})
+		})
+	}
+}
+
+func TestEqualPartial(t *testing.T) {
+	actual := compareTestStruct{
+		ID:        1337,
+		Name:      "John",
+		Tags:      []string{"a", "b"},
+		Labels:    map[string]string{"env": "prod", "team": "core"},
+		CreatedAt: time.Now(),
+		Meta:      compareTestStructNested{ID: 7, Version: 2},
+	}
+
+	EqualPartial(t, compareTestStruct{Name: "John"}, actual)
+	EqualPartial(t, compareTestStruct{Meta: compareTestStructNested{Version: 2}}, actual)
+	EqualPartial(t, compareTestStruct{Labels: map[string]string{"env": "prod"}}, actual)
+	EqualPartial(t, []compareTestStruct{{Name: "John"}}, []compareTestStruct{actual})
+	EqualPartial(t, &compareTestStruct{Tags: []string{"a", "b"}}, &actual)
+}
+
+func TestEqualPartial_fails(t *testing.T) {
+	actual := compareTestStruct{
+		Name:   "John",
+		Tags:   []string{"a", "b"},
+		Labels: map[string]string{"env": "prod"},
+		Meta:   compareTestStructNested{ID: 7},
+	}
+
+	tests := []struct {
+		name     string
+		template any
+	}{
+		{name: "Field", template: compareTestStruct{Name: "Bob"}},
+		{name: "Nested field", template: compareTestStruct{Meta: compareTestStructNested{ID: 8}}},
+		{name: "Slice length", template: compareTestStruct{Tags: []string{"a"}}},
+		{name: "Missing key", template: compareTestStruct{Labels: map[string]string{"team": "core"}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			TestFails(t, func(t TestingPackageWithFailFunctions) {
+				EqualPartial(t, test.template, actual)
+			})
+		})
+	}
+}
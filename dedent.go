@@ -1,6 +1,7 @@
 package assert
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
 )
@@ -15,6 +16,9 @@ var (
 // This can be used to make multiline strings to line up with the left edge of
 // the display, while still presenting them in the source code in indented
 // form.
+//
+// Tabs and spaces are both treated as whitespace, but they are not equal.
+// Use DedentTabs to compare indentation by columns.
 func Dedent(text string) string {
 	var margin string
 
@@ -43,7 +47,101 @@ func Dedent(text string) string {
 	}
 
 	if margin != "" {
-		text = regexp.MustCompile("(?m)^"+margin).ReplaceAllString(text, "")
+		lines := strings.Split(text, "\n")
+		for i, line := range lines {
+			lines[i] = strings.TrimPrefix(line, margin)
+		}
+		text = strings.Join(lines, "\n")
 	}
 	return text
 }
+
+// DedentTrim works like Dedent, but also removes the leading newline and the indentation of the last line,
+// which Go raw string literals usually have.
+//
+// Example:
+//
+//	expected := assert.DedentTrim(`
+//		first line
+//		second line
+//	`) // => "first line\nsecond line\n"
+func DedentTrim(text string) string {
+	text = Dedent(text)
+	text = strings.TrimPrefix(text, "\n")
+
+	return text
+}
+
+// DedentTabs works like Dedent, but expands tabs in the indentation to tabWidth columns first,
+// so that lines indented with a mix of tabs and spaces share a margin when they start at the same column.
+// The remaining indentation is returned as spaces.
+//
+// Example:
+//
+//	assert.DedentTabs("\tfoo\n        bar", 8) // => "foo\nbar"
+func DedentTabs(text string, tabWidth int) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		content := strings.TrimLeft(line, " \t")
+		indent := line[:len(line)-len(content)]
+
+		column := 0
+		for _, r := range indent {
+			if r == '\t' && tabWidth > 0 {
+				column += tabWidth - column%tabWidth
+			} else {
+				column++
+			}
+		}
+		lines[i] = strings.Repeat(" ", column) + content
+	}
+
+	return Dedent(strings.Join(lines, "\n"))
+}
+
+// Indent adds prefix to the beginning of the lines in text, for which predicate returns true.
+// The predicate gets the line without its line ending. If predicate is nil, the prefix is added to every line
+// that does not consist solely of whitespace. It is the counterpart of Dedent.
+//
+// Example:
+//
+//	assert.Indent("a\n\nb\n", "> ", nil) // => "> a\n\n> b\n"
+func Indent(text, prefix string, predicate func(line string) bool) string {
+	if predicate == nil {
+		predicate = func(line string) bool {
+			return strings.TrimSpace(line) != ""
+		}
+	}
+
+	var result strings.Builder
+	for _, line := range strings.SplitAfter(text, "\n") {
+		if line == "" {
+			continue
+		}
+		if predicate(strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")) {
+			result.WriteString(prefix)
+		}
+		result.WriteString(line)
+	}
+
+	return result.String()
+}
+
+// Heredoc dedents a template like DedentTrim and formats it with the args, using the same formatting as fmt.Sprintf().
+// The template is dedented before it is formatted, so multiline args do not change the margin.
+// Without args, the template is not formatted, so it can contain a literal %.
+//
+// Example:
+//
+//	config := assert.Heredoc(`
+//		name: %s
+//		port: %d
+//	`, "api", 8080) // => "name: api\nport: 8080\n"
+func Heredoc(template string, args ...any) string {
+	template = DedentTrim(template)
+	if len(args) == 0 {
+		return template
+	}
+
+	return fmt.Sprintf(template, args...)
+}
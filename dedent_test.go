@@ -155,3 +155,115 @@ func ExampleDedent() {
 	//		Curabitur justo tellus, facilisis nec efficitur dictum,
 	//		fermentum vitae ligula. Sed eu convallis sapien.
 }
+
+func TestDedentRegexpCharacters(t *testing.T) {
+	t.Parallel()
+	Equal(t, "a.*\nb(\n", Dedent("  a.*\n  b(\n"))
+}
+
+func TestDedentTrim(t *testing.T) {
+	t.Parallel()
+	texts := []dedentTest{
+		{
+			text: `
+			first line
+				second line
+			`,
+			expect: "first line\n\tsecond line\n",
+		},
+		{
+			text:   "\n  foo\n  bar",
+			expect: "foo\nbar",
+		},
+		{
+			text:   "foo",
+			expect: "foo",
+		},
+	}
+
+	for _, text := range texts {
+		Equal(t, text.expect, DedentTrim(text.text))
+	}
+}
+
+func TestDedentTabs(t *testing.T) {
+	t.Parallel()
+	texts := []dedentTest{
+		{
+			text:   "\tfoo\n        bar",
+			expect: "foo\nbar",
+		},
+		{
+			text:   "  \tfoo\n    \t  bar",
+			expect: "foo\n  bar",
+		},
+		{
+			text:   "\thello\tthere",
+			expect: "hello\tthere",
+		},
+	}
+
+	for _, text := range texts {
+		Equal(t, text.expect, DedentTabs(text.text, 8))
+	}
+
+	Equal(t, "foo\n  bar", DedentTabs("\tfoo\n      bar", 4))
+}
+
+func TestIndent(t *testing.T) {
+	t.Parallel()
+	texts := []dedentTest{
+		{
+			text:   "a\nb\n",
+			expect: "> a\n> b\n",
+		},
+		{
+			text:   "a\n\n  \nb",
+			expect: "> a\n\n  \n> b",
+		},
+		{
+			text:   "a\r\n\r\nb\r\n",
+			expect: "> a\r\n\r\n> b\r\n",
+		},
+		{
+			text:   "",
+			expect: "",
+		},
+	}
+
+	for _, text := range texts {
+		Equal(t, text.expect, Indent(text.text, "> ", nil))
+	}
+
+	Equal(t, "> a\n> \n> b", Indent("a\n\nb", "> ", func(string) bool { return true }))
+	Equal(t, "a\nb", Dedent(Indent("a\nb", "  ", nil)))
+}
+
+func TestHeredoc(t *testing.T) {
+	t.Parallel()
+	Equal(t, "name: api\nport: 8080\n", Heredoc(`
+		name: %s
+		port: %d
+	`, "api", 8080))
+
+	Equal(t, "progress: 100%\n", Heredoc(`
+		progress: 100%
+	`))
+
+	Equal(t, "items:\nfirst\nsecond\n", Heredoc(`
+		items:
+		%s
+	`, "first\nsecond"))
+}
+
+func ExampleHeredoc() {
+	fmt.Print(Heredoc(`
+		[server]
+		host = %q
+		port = %d
+	`, "localhost", 8080))
+	// Output:
+	// [server]
+	// host = "localhost"
+	// port = 8080
+}
@@ -0,0 +1,167 @@
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/chalk-ai/assert/internal"
+)
+
+// ErrorAs asserts that an error of type T is inside the error chain of err, and returns it.
+// T has to be an interface, or a type that implements error.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	pathErr := assert.ErrorAs[*fs.PathError](t, err)
+//	assert.Equal(t, "config.yaml", pathErr.Path)
+func ErrorAs[T any](t testRunner, err error, msg ...any) T {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	var target T
+	targetType := reflect.TypeFor[T]()
+	if targetType.Kind() != reflect.Interface && !targetType.Implements(reflect.TypeFor[error]()) {
+		internal.Fail(t, fmt.Sprintf("The target type %s !!does not implement error!!.", targetType), internal.Objects{}, msg...)
+		return target
+	}
+
+	if !errors.As(err, &target) {
+		internal.Fail(t, fmt.Sprintf("An error of type %s !!should be in the error chain!! of err.", targetType), internal.Objects{
+			internal.NewErrorChainObject("Error Chain", err),
+		}, msg...)
+	}
+
+	return target
+}
+
+// ErrorContains asserts that the message of an error contains a substring.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.ErrorContains(t, err, "permission denied")
+func ErrorContains(t testRunner, err error, substring string, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	if err == nil {
+		internal.Fail(t, "An error that !!should not be nil!! is nil.", internal.NewObjectsSingleNamed("Expected Substring", substring+"\n"), msg...)
+		return
+	}
+
+	if !strings.Contains(err.Error(), substring) {
+		internal.Fail(t, "The error message !!does not contain!! the expected substring.", internal.Objects{
+			internal.NewObjectsSingleNamed("Expected Substring", substring+"\n")[0],
+			internal.NewErrorChainObject("Error Chain", err),
+		}, msg...)
+	}
+}
+
+// ErrorMatches asserts that the message of an error matches a regular expression.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.ErrorMatches(t, err, `^open .*: no such file or directory$`)
+func ErrorMatches(t testRunner, err error, regex string, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	re, compileErr := regexp.Compile(regex)
+	if compileErr != nil {
+		internal.Fail(t, "The regex pattern !!is invalid!!.", internal.Objects{
+			internal.NewObjectsSingleNamed("Regex Pattern", regex+"\n")[0],
+			internal.NewObjectsSingleNamed("Error", compileErr.Error()+"\n")[0],
+		}, msg...)
+		return
+	}
+
+	if err == nil {
+		internal.Fail(t, "An error that !!should not be nil!! is nil.", internal.NewObjectsSingleNamed("Regex Pattern", regex+"\n"), msg...)
+		return
+	}
+
+	if !re.MatchString(err.Error()) {
+		internal.Fail(t, "The error message !!does not match!! the regex pattern.", internal.Objects{
+			internal.NewObjectsSingleNamed("Regex Pattern", regex+"\n")[0],
+			internal.NewErrorChainObject("Error Chain", err),
+		}, msg...)
+	}
+}
+
+// ErrorIsAll asserts that every target is inside the error tree of err.
+// This is useful for errors created with errors.Join.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	err := errors.Join(ErrNotFound, ErrTimeout)
+//	assert.ErrorIsAll(t, err, []error{ErrNotFound, ErrTimeout})
+func ErrorIsAll(t testRunner, err error, targets []error, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	var missing []error
+	for _, target := range targets {
+		if !errors.Is(err, target) {
+			missing = append(missing, target)
+		}
+	}
+
+	if len(missing) > 0 {
+		internal.Fail(t, fmt.Sprintf("%d of %d target errors !!are not in the error tree!! of err.", len(missing), len(targets)), internal.Objects{
+			errorListObject("Missing Targets", missing),
+			internal.NewErrorChainObject("Error Chain", err),
+		}, msg...)
+	}
+}
+
+// ErrorIsAny asserts that at least one target is inside the error tree of err.
+// This is useful for errors created with errors.Join.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.ErrorIsAny(t, err, []error{ErrNotFound, ErrTimeout})
+func ErrorIsAny(t testRunner, err error, targets []error, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	for _, target := range targets {
+		if errors.Is(err, target) {
+			return
+		}
+	}
+
+	internal.Fail(t, "None of the target errors !!are in the error tree!! of err.", internal.Objects{
+		errorListObject("Targets", targets),
+		internal.NewErrorChainObject("Error Chain", err),
+	}, msg...)
+}
+
+// errorListObject renders the trees of multiple errors below each other.
+func errorListObject(name string, errs []error) internal.Object {
+	var data strings.Builder
+	for _, err := range errs {
+		data.WriteString(internal.RenderErrorChain(err))
+	}
+
+	object := internal.NewErrorChainObject(name, nil)
+	object.Data = data.String()
+
+	return object
+}
@@ -0,0 +1,116 @@
+package assert_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"testing"
+
+	. "github.com/chalk-ai/assert"
+)
+
+var (
+	errTestNotFound = errors.New("not found")
+	errTestTimeout  = errors.New("timeout")
+)
+
+func TestErrorAs(t *testing.T) {
+	err := fmt.Errorf("loading config: %w", &fs.PathError{Op: "open", Path: "config.yaml", Err: fs.ErrNotExist})
+
+	pathErr := ErrorAs[*fs.PathError](t, err)
+	Equal(t, "config.yaml", pathErr.Path)
+
+	timeoutErr := ErrorAs[interface{ Timeout() bool }](t, &fs.PathError{Err: timeoutError{}})
+	True(t, timeoutErr.Timeout())
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string { return "timeout" }
+
+func (timeoutError) Timeout() bool { return true }
+
+func TestErrorAs_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		ErrorAs[*fs.PathError](t, io.EOF)
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		ErrorAs[*fs.PathError](t, nil)
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		ErrorAs[string](t, io.EOF)
+	})
+}
+
+func TestErrorContains(t *testing.T) {
+	ErrorContains(t, fmt.Errorf("reading: %w", io.EOF), "EOF")
+}
+
+func TestErrorContains_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		ErrorContains(t, io.EOF, "timeout")
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		ErrorContains(t, nil, "timeout")
+	})
+}
+
+func TestErrorMatches(t *testing.T) {
+	ErrorMatches(t, fmt.Errorf("code %d", 404), `^code \d+$`)
+}
+
+func TestErrorMatches_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		ErrorMatches(t, io.EOF, `^code \d+$`)
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		ErrorMatches(t, nil, `^code \d+$`)
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		ErrorMatches(t, io.EOF, `(`)
+	})
+}
+
+func TestErrorIsAll(t *testing.T) {
+	err := fmt.Errorf("request failed: %w", errors.Join(errTestNotFound, errTestTimeout))
+	ErrorIsAll(t, err, []error{errTestNotFound, errTestTimeout})
+}
+
+func TestErrorIsAll_fails(t *testing.T) {
+	var tm testMock
+	ErrorIsAll(&tm, errors.Join(errTestNotFound, io.EOF), []error{errTestNotFound, errTestTimeout})
+
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "1 of 2 target errors")
+	Contains(t, tm.ErrorMessage, `├── *errors.errorString: "not found"`)
+}
+
+func TestErrorIsAny(t *testing.T) {
+	ErrorIsAny(t, errors.Join(errTestNotFound, io.EOF), []error{errTestTimeout, io.EOF})
+}
+
+func TestErrorIsAny_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		ErrorIsAny(t, io.EOF, []error{errTestNotFound, errTestTimeout})
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		ErrorIsAny(t, nil, []error{errTestNotFound})
+	})
+}
+
+func TestErrorIs_nil(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		ErrorIs(t, nil, io.EOF)
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		NotErrorIs(t, nil, nil)
+	})
+}
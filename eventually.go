@@ -0,0 +1,323 @@
+package assert
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/pterm/pterm"
+
+	"github.com/chalk-ai/assert/internal"
+)
+
+// Collect collects the failures of a single attempt of EventuallyWith and ConsistentlyWith.
+// It can be passed to every assertion in place of a *testing.T.
+type Collect struct {
+	ctx      context.Context
+	failed   bool
+	messages []string
+}
+
+// Error records a failure of the current attempt.
+func (c *Collect) Error(args ...any) {
+	c.failed = true
+	c.messages = append(c.messages, fmt.Sprint(args...))
+}
+
+// Errorf records a failure of the current attempt.
+func (c *Collect) Errorf(format string, args ...any) {
+	c.Error(fmt.Sprintf(format, args...))
+}
+
+// Fail marks the current attempt as failed.
+func (c *Collect) Fail() {
+	c.failed = true
+}
+
+// FailNow marks the current attempt as failed and stops it.
+func (c *Collect) FailNow() {
+	c.Fail()
+	runtime.Goexit()
+}
+
+// Fatal records a failure of the current attempt and stops it.
+func (c *Collect) Fatal(args ...any) {
+	c.Error(args...)
+	runtime.Goexit()
+}
+
+// Fatalf records a failure of the current attempt and stops it.
+func (c *Collect) Fatalf(format string, args ...any) {
+	c.Errorf(format, args...)
+	runtime.Goexit()
+}
+
+// Failed reports whether the current attempt has failed.
+func (c *Collect) Failed() bool {
+	return c.failed
+}
+
+// Context returns the context of the polling assertion.
+// It is canceled when the assertion finishes, or the test ends.
+func (c *Collect) Context() context.Context {
+	return c.ctx
+}
+
+func (c *Collect) failure() string {
+	if len(c.messages) == 0 {
+		return "The attempt failed without a message.\n"
+	}
+
+	return strings.Join(c.messages, "\n")
+}
+
+// attempt runs f in its own goroutine, so that FailNow can stop it without stopping the test.
+// It returns false, if the context is done before f returns. The goroutine is abandoned then
+// and the Collect must not be read anymore, as f can still write to it.
+func (c *Collect) attempt(f func(c *Collect)) bool {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer func() {
+			if r := recover(); r != nil {
+				c.Error(fmt.Sprintf("The attempt panicked: %v\n", r))
+			}
+		}()
+		f(c)
+	}()
+
+	select {
+	case <-done:
+		return true
+	case <-c.ctx.Done():
+		// Prefer a result, if the attempt returned at the same time.
+		select {
+		case <-done:
+			return true
+		default:
+			return false
+		}
+	}
+}
+
+type contextTest interface {
+	Context() context.Context
+}
+
+// testContext returns the context of the test, which is canceled when the test finishes, before its cleanup functions run.
+func testContext(t testRunner) context.Context {
+	if test, ok := t.(contextTest); ok {
+		return test.Context()
+	}
+
+	return context.Background()
+}
+
+func conditionCollector(condition func() bool) func(c *Collect) {
+	return func(c *Collect) {
+		if !condition() {
+			c.Error("The condition returned false.\n")
+		}
+	}
+}
+
+// Eventually asserts that a condition returns true within a given time.
+// The condition is checked immediately and then once every interval.
+// Polling stops when the test finishes.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.Eventually(t, func() bool {
+//		return server.Ready()
+//	}, 5*time.Second, 100*time.Millisecond)
+func Eventually(t testRunner, condition func() bool, timeout, interval time.Duration, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	EventuallyContext(testContext(t), t, conditionCollector(condition), timeout, interval, msg...)
+}
+
+// EventuallyWith asserts that every assertion inside of collect passes within a given time.
+// The assertions have to be called with the passed *Collect instead of the test.
+// If the time runs out, only the failures of the last attempt are reported.
+// An attempt that is still running when the time runs out is abandoned and reported as not returned.
+// Polling stops when the test finishes.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.EventuallyWith(t, func(c *assert.Collect) {
+//		resp, err := http.Get(url)
+//		assert.NoError(c, err)
+//		assert.Equal(c, http.StatusOK, resp.StatusCode)
+//	}, 5*time.Second, 100*time.Millisecond)
+func EventuallyWith(t testRunner, collect func(c *Collect), timeout, interval time.Duration, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	EventuallyContext(testContext(t), t, collect, timeout, interval, msg...)
+}
+
+// EventuallyContext works like EventuallyWith, but polling also stops when ctx is canceled, which fails the test.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.EventuallyContext(ctx, t, func(c *assert.Collect) {
+//		assert.True(c, server.Ready())
+//	}, 5*time.Second, 100*time.Millisecond)
+func EventuallyContext(ctx context.Context, t testRunner, collect func(c *Collect), timeout, interval time.Duration, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	pollCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for attempts := 1; ; attempts++ {
+		last := &Collect{ctx: pollCtx}
+		returned := last.attempt(collect)
+		if returned && !last.failed {
+			return
+		}
+
+		if returned {
+			select {
+			case <-ticker.C:
+				continue
+			case <-pollCtx.Done():
+			}
+		}
+
+		if testContext(t).Err() != nil {
+			// The test is already over, reporting a failure now would panic.
+			return
+		}
+
+		message := fmt.Sprintf("The condition !!was not met within %s!!, after %d attempts.", timeout, attempts)
+		if ctx.Err() != nil {
+			message = fmt.Sprintf("The condition !!was not met before the context was canceled!!, after %d attempts.", attempts)
+		}
+		failure := unfinishedAttemptObject("Last Failure")
+		if returned {
+			failure = lastFailureObject("Last Failure", last)
+		}
+		internal.Fail(t, message, internal.Objects{failure}, msg...)
+		return
+	}
+}
+
+// Consistently asserts that a condition returns true during the whole duration.
+// The condition is checked immediately and then once every interval.
+// Polling stops when the test finishes.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.Consistently(t, func() bool {
+//		return cache.Len() < 100
+//	}, time.Second, 10*time.Millisecond)
+func Consistently(t testRunner, condition func() bool, duration, interval time.Duration, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	ConsistentlyContext(testContext(t), t, conditionCollector(condition), duration, interval, msg...)
+}
+
+// ConsistentlyWith asserts that every assertion inside of collect passes during the whole duration.
+// The assertions have to be called with the passed *Collect instead of the test.
+// The first failing attempt is reported. An attempt that is still running when the duration ends is not waited for.
+// Polling stops when the test finishes.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.ConsistentlyWith(t, func(c *assert.Collect) {
+//		assert.Len(c, queue.Items(), 0)
+//	}, time.Second, 10*time.Millisecond)
+func ConsistentlyWith(t testRunner, collect func(c *Collect), duration, interval time.Duration, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	ConsistentlyContext(testContext(t), t, collect, duration, interval, msg...)
+}
+
+// ConsistentlyContext works like ConsistentlyWith, but polling also stops when ctx is canceled.
+// A canceled context ends the check early without failing the test.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.ConsistentlyContext(ctx, t, func(c *assert.Collect) {
+//		assert.False(c, worker.Crashed())
+//	}, time.Second, 10*time.Millisecond)
+func ConsistentlyContext(ctx context.Context, t testRunner, collect func(c *Collect), duration, interval time.Duration, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	pollCtx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for attempts := 1; ; attempts++ {
+		current := &Collect{ctx: pollCtx}
+		if !current.attempt(collect) {
+			// The duration ended, or the test or ctx were canceled, while the attempt was running.
+			// The attempt is abandoned and the check ends without a failure.
+			return
+		}
+
+		if current.failed {
+			if testContext(t).Err() != nil {
+				return
+			}
+
+			internal.Fail(t, fmt.Sprintf("The condition !!should be met for %s!!, but failed in attempt %d.", duration, attempts),
+				internal.Objects{lastFailureObject("Failure", current)}, msg...)
+			return
+		}
+
+		select {
+		case <-ticker.C:
+		case <-pollCtx.Done():
+			return
+		}
+	}
+}
+
+func unfinishedAttemptObject(name string) internal.Object {
+	return internal.Object{
+		Name:      name,
+		NameStyle: pterm.NewStyle(pterm.FgLightRed),
+		Data:      "The attempt did not return in time and was abandoned.\n",
+		Raw:       true,
+	}
+}
+
+func lastFailureObject(name string, c *Collect) internal.Object {
+	return internal.Object{
+		Name:      name,
+		NameStyle: pterm.NewStyle(pterm.FgLightRed),
+		Data:      c.failure(),
+		Raw:       true,
+	}
+}
@@ -0,0 +1,147 @@
+package assert_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/chalk-ai/assert"
+)
+
+func TestEventually(t *testing.T) {
+	var calls atomic.Int32
+	Eventually(t, func() bool {
+		return calls.Add(1) >= 3
+	}, time.Second, time.Millisecond)
+
+	Equal(t, int32(3), calls.Load())
+}
+
+func TestEventually_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		Eventually(t, func() bool { return false }, 20*time.Millisecond, 5*time.Millisecond)
+	})
+}
+
+func TestEventually_blocking_condition(t *testing.T) {
+	block := make(chan struct{})
+	t.Cleanup(func() { close(block) })
+
+	var tm testMock
+	start := time.Now()
+	Eventually(&tm, func() bool {
+		<-block
+		return true
+	}, 20*time.Millisecond, 5*time.Millisecond)
+
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "did not return")
+	Less(t, time.Since(start), time.Second)
+}
+
+func TestEventuallyWith(t *testing.T) {
+	var calls atomic.Int32
+	EventuallyWith(t, func(c *Collect) {
+		n := calls.Add(1)
+		NoError(c, nil)
+		GreaterOrEqual(c, n, int32(3))
+	}, time.Second, time.Millisecond)
+}
+
+func TestEventuallyWith_fails(t *testing.T) {
+	var tm testMock
+	EventuallyWith(&tm, func(c *Collect) {
+		Equal(c, "expected", "actual")
+	}, 20*time.Millisecond, 5*time.Millisecond)
+
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "Last Failure")
+	Contains(t, tm.ErrorMessage, "attempts")
+}
+
+func TestEventuallyWith_fail_now(t *testing.T) {
+	var attempts atomic.Int32
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		EventuallyWith(t, func(c *Collect) {
+			attempts.Add(1)
+			c.FailNow()
+			panic("FailNow should stop the attempt")
+		}, 20*time.Millisecond, 5*time.Millisecond)
+	})
+
+	Greater(t, attempts.Load(), int32(1))
+}
+
+func TestEventuallyContext_fails(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var tm testMock
+	EventuallyContext(ctx, &tm, func(c *Collect) {
+		c.Fail()
+	}, time.Minute, time.Millisecond)
+
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "context was canceled")
+}
+
+func TestConsistently(t *testing.T) {
+	var calls atomic.Int32
+	Consistently(t, func() bool {
+		calls.Add(1)
+		return true
+	}, 20*time.Millisecond, 5*time.Millisecond)
+
+	Greater(t, calls.Load(), int32(1))
+}
+
+func TestConsistently_fails(t *testing.T) {
+	var calls atomic.Int32
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		Consistently(t, func() bool {
+			return calls.Add(1) < 3
+		}, time.Second, time.Millisecond)
+	})
+
+	Equal(t, int32(3), calls.Load())
+}
+
+func TestConsistentlyWith_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		ConsistentlyWith(t, func(c *Collect) {
+			True(c, false)
+		}, 20*time.Millisecond, 5*time.Millisecond)
+	})
+}
+
+func TestConsistently_slow_condition(t *testing.T) {
+	Consistently(t, func() bool {
+		time.Sleep(40 * time.Millisecond)
+		return true
+	}, 100*time.Millisecond, 10*time.Millisecond)
+}
+
+func TestConsistently_blocking_condition(t *testing.T) {
+	block := make(chan struct{})
+	t.Cleanup(func() { close(block) })
+
+	var tm testMock
+	start := time.Now()
+	Consistently(&tm, func() bool {
+		<-block
+		return true
+	}, 20*time.Millisecond, 5*time.Millisecond)
+
+	False(t, tm.ErrorCalled)
+	Less(t, time.Since(start), time.Second)
+}
+
+func TestConsistentlyContext(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	ConsistentlyContext(ctx, t, func(c *Collect) {
+		NotNil(c, c.Context())
+	}, time.Minute, time.Millisecond)
+}
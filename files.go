@@ -0,0 +1,268 @@
+package assert
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/chalk-ai/assert/internal"
+)
+
+// FileContent asserts that a file has the expected content.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.FileContent(t, "out/config.yaml", "port: 8080\n")
+func FileContent(t testRunner, file string, expected string, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	FSFileContent(t, os.DirFS(filepath.Dir(file)), filepath.Base(file), expected, msg...)
+}
+
+// FSFileContent asserts that a file in a file system has the expected content.
+// It works with every fs.FS, like embed.FS, fstest.MapFS or os.DirFS.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.FSFileContent(t, templates, "index.html", expectedHTML)
+func FSFileContent(t testRunner, fsys fs.FS, name string, expected string, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	content, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		internal.Fail(t, "A file !!could not be read!!.", internal.NewObjectsSingleNamed("Error", err), msg...)
+		return
+	}
+
+	if actual := string(content); actual != expected {
+		internal.Fail(t, fmt.Sprintf("The content of the file %q !!is not equal!! to the expected content.", name),
+			internal.Objects{internal.NewDiffObject(expected, actual, true)}, msg...)
+	}
+}
+
+// FileMatches asserts that the content of a file matches a regular expression.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.FileMatches(t, "server.log", `(?m)^listening on :\d+$`)
+func FileMatches(t testRunner, file string, regex string, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	re, err := regexp.Compile(regex)
+	if err != nil {
+		internal.Fail(t, "The regular expression !!is invalid!!.", internal.NewObjectsSingleNamed("Error", err), msg...)
+		return
+	}
+
+	content, err := os.ReadFile(file)
+	if err != nil {
+		internal.Fail(t, "A file !!could not be read!!.", internal.NewObjectsSingleNamed("Error", err), msg...)
+		return
+	}
+
+	if !re.Match(content) {
+		internal.Fail(t, fmt.Sprintf("The content of the file %q !!does not match!! the regular expression.", file), internal.Objects{
+			internal.NewObjectsSingleNamed("Regular Expression", regex)[0],
+			internal.NewObjectsSingleNamed("Content", string(content))[0],
+		}, msg...)
+	}
+}
+
+// FileMode asserts that a file has the expected mode.
+// If mode contains only permission bits, only the permissions of the file are compared.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.FileMode(t, "bin/run.sh", 0o755)
+func FileMode(t testRunner, file string, mode fs.FileMode, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	info, err := os.Stat(file)
+	if err != nil {
+		internal.Fail(t, "A file !!could not be read!!.", internal.NewObjectsSingleNamed("Error", err), msg...)
+		return
+	}
+
+	actual := info.Mode()
+	if mode&fs.ModeType == 0 {
+		actual = actual.Perm()
+	}
+
+	if actual != mode {
+		internal.Fail(t, fmt.Sprintf("The file %q !!does not have the mode %s!!.", file, mode),
+			internal.NewObjectsExpectedActual(mode.String(), actual.String()), msg...)
+	}
+}
+
+// DirTreeEqual asserts that two directories contain the same files with the same content, recursively.
+// Added, removed and modified files are reported, with a diff for every modified file.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.DirTreeEqual(t, "testdata/expected", outputDir)
+func DirTreeEqual(t testRunner, expectedDir, actualDir string, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	FSTreeEqual(t, os.DirFS(expectedDir), os.DirFS(actualDir), msg...)
+}
+
+// FSTreeEqual asserts that two file systems contain the same files with the same content.
+// It works with every fs.FS, like embed.FS, fstest.MapFS or os.DirFS.
+// Added, removed and modified files are reported, with a diff for every modified file.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	//go:embed testdata/expected
+//	var expected embed.FS
+//
+//	sub, _ := fs.Sub(expected, "testdata/expected")
+//	assert.FSTreeEqual(t, sub, os.DirFS(outputDir))
+func FSTreeEqual(t testRunner, expected, actual fs.FS, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	expectedFiles, err := readTree(expected)
+	if err != nil {
+		internal.Fail(t, "The expected tree !!could not be read!!.", internal.NewObjectsSingleNamed("Error", err), msg...)
+		return
+	}
+	actualFiles, err := readTree(actual)
+	if err != nil {
+		internal.Fail(t, "The actual tree !!could not be read!!.", internal.NewObjectsSingleNamed("Error", err), msg...)
+		return
+	}
+
+	var rows [][]string
+	var diffs internal.Objects
+	for _, name := range sortedMapKeys(expectedFiles) {
+		actualContent, ok := actualFiles[name]
+		switch {
+		case !ok:
+			rows = append(rows, []string{name, "removed"})
+		case !bytes.Equal(expectedFiles[name], actualContent):
+			rows = append(rows, []string{name, "modified"})
+			diff := internal.NewDiffObject(string(expectedFiles[name]), string(actualContent), true)
+			diff.Name = "Difference in " + name
+			diffs = append(diffs, diff)
+		}
+	}
+	for _, name := range sortedMapKeys(actualFiles) {
+		if _, ok := expectedFiles[name]; !ok {
+			rows = append(rows, []string{name, "added"})
+		}
+	}
+
+	if len(rows) > 0 {
+		sort.SliceStable(rows, func(i, j int) bool { return rows[i][0] < rows[j][0] })
+		internal.Fail(t, fmt.Sprintf("Two file trees !!are not equal!!, %d file(s) differ.", len(rows)),
+			append(internal.Objects{internal.NewTableObject("Changes", []string{"File", "Status"}, rows)}, diffs...), msg...)
+	}
+}
+
+// readTree returns the content of every file in a file system. Empty directories are included with a trailing slash.
+func readTree(fsys fs.FS) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			entries, err := fs.ReadDir(fsys, name)
+			if err != nil {
+				return err
+			}
+			if len(entries) == 0 && name != "." {
+				files[name+"/"] = nil
+			}
+			return nil
+		}
+
+		content, err := fs.ReadFile(fsys, name)
+		files[name] = content
+		return err
+	})
+
+	return files, err
+}
+
+type tempDirer interface {
+	TempDir() string
+}
+
+// WriteTree creates files in a new temporary directory of the test, and returns the directory.
+// The keys are slash-separated paths relative to the directory, and the values are the content of the files.
+// Parent directories are created as needed, and a key with a trailing slash creates an empty directory.
+//
+// Example:
+//
+//	dir := assert.WriteTree(t, map[string]string{
+//		"go.mod":      "module example.com/app\n",
+//		"cmd/main.go": "package main\n",
+//		"testdata/":   "",
+//	})
+func WriteTree(t testRunner, files map[string]string) string {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	test, ok := t.(tempDirer)
+	if !ok {
+		internal.Fail(t, "The test !!does not support TempDir!!, which is needed to write a file tree.", internal.Objects{})
+		t.FailNow()
+		return ""
+	}
+
+	root := test.TempDir()
+	for _, name := range sortedMapKeys(files) {
+		file := filepath.Join(root, filepath.FromSlash(path.Clean(name)))
+		if !strings.HasPrefix(file, root+string(filepath.Separator)) {
+			internal.Fail(t, fmt.Sprintf("The path %q !!is outside of the tree!!.", name), internal.Objects{})
+			t.FailNow()
+			return root
+		}
+
+		var err error
+		if strings.HasSuffix(name, "/") {
+			err = os.MkdirAll(file, 0o755)
+		} else if err = os.MkdirAll(filepath.Dir(file), 0o755); err == nil {
+			err = os.WriteFile(file, []byte(files[name]), 0o644)
+		}
+		if err != nil {
+			internal.Fail(t, "The file tree !!could not be written!!.", internal.NewObjectsSingleNamed("Error", err))
+			t.FailNow()
+			return root
+		}
+	}
+
+	return root
+}
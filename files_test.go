@@ -0,0 +1,110 @@
+package assert_test
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"testing/fstest"
+
+	. "github.com/chalk-ai/assert"
+)
+
+func TestWriteTree(t *testing.T) {
+	dir := WriteTree(t, map[string]string{
+		"go.mod":      "module example.com/app\n",
+		"cmd/main.go": "package main\n",
+		"testdata/":   "",
+	})
+
+	FileContent(t, filepath.Join(dir, "go.mod"), "module example.com/app\n")
+	FileContent(t, filepath.Join(dir, "cmd", "main.go"), "package main\n")
+	DirExists(t, filepath.Join(dir, "testdata"))
+	DirEmpty(t, filepath.Join(dir, "testdata"))
+}
+
+func TestFileContent_fails(t *testing.T) {
+	dir := WriteTree(t, map[string]string{"a.txt": "hello\n"})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		FileContent(t, filepath.Join(dir, "a.txt"), "world\n")
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		FileContent(t, filepath.Join(dir, "missing.txt"), "")
+	})
+}
+
+func TestFSFileContent(t *testing.T) {
+	FSFileContent(t, fstest.MapFS{"a/b.txt": {Data: []byte("b")}}, "a/b.txt", "b")
+}
+
+func TestFileMatches(t *testing.T) {
+	dir := WriteTree(t, map[string]string{"server.log": "starting\nlistening on :8080\n"})
+
+	FileMatches(t, filepath.Join(dir, "server.log"), `(?m)^listening on :\d+$`)
+}
+
+func TestFileMatches_fails(t *testing.T) {
+	dir := WriteTree(t, map[string]string{"server.log": "starting\n"})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		FileMatches(t, filepath.Join(dir, "server.log"), `listening`)
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		FileMatches(t, filepath.Join(dir, "server.log"), `(`)
+	})
+}
+
+func TestFileMode(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file permissions are not supported on windows")
+	}
+
+	dir := WriteTree(t, map[string]string{"run.sh": "#!/bin/sh\n", "private/": ""})
+	file := filepath.Join(dir, "run.sh")
+	NoError(t, os.Chmod(file, 0o755))
+	NoError(t, os.Chmod(filepath.Join(dir, "private"), 0o700))
+
+	FileMode(t, file, 0o755)
+	FileMode(t, filepath.Join(dir, "private"), os.ModeDir|0o700)
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		FileMode(t, file, 0o644)
+	})
+}
+
+func TestDirTreeEqual(t *testing.T) {
+	files := map[string]string{"a.txt": "a", "sub/b.txt": "b", "empty/": ""}
+
+	DirTreeEqual(t, WriteTree(t, files), WriteTree(t, files))
+}
+
+func TestDirTreeEqual_fails(t *testing.T) {
+	expected := WriteTree(t, map[string]string{"same.txt": "same", "modified.txt": "old\n", "removed.txt": "x"})
+	actual := WriteTree(t, map[string]string{"same.txt": "same", "modified.txt": "new\n", "added.txt": "y"})
+
+	var tm testMock
+	DirTreeEqual(&tm, expected, actual)
+
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "3 file(s) differ")
+	Contains(t, tm.ErrorMessage, "Difference in modified.txt")
+	NotContains(t, tm.ErrorMessage, "same.txt")
+}
+
+func TestFSTreeEqual(t *testing.T) {
+	dir := WriteTree(t, map[string]string{"a.txt": "a", "sub/b.txt": "b"})
+
+	FSTreeEqual(t, fstest.MapFS{
+		"a.txt":     {Data: []byte("a")},
+		"sub/b.txt": {Data: []byte("b")},
+	}, os.DirFS(dir))
+}
+
+func TestFSTreeEqual_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		FSTreeEqual(t, fstest.MapFS{"a.txt": {Data: []byte("a")}}, fstest.MapFS{"a.txt": {Data: []byte("b")}})
+	})
+}
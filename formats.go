@@ -0,0 +1,245 @@
+package assert
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	jd "github.com/josephburnett/jd/lib"
+	"github.com/pterm/pterm"
+	"gopkg.in/yaml.v3"
+
+	"github.com/chalk-ai/assert/internal"
+)
+
+// YAMLEqual asserts that two YAML strings are semantically equal. The formatting, comments and the order of mapping keys are ignored.
+// Streams with multiple documents are compared document by document, so the paths of differences start with the index of the document.
+// Differences are reported with their path, like JSONEqual does.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.YAMLEqual(t, "kind: Service\nmetadata:\n  name: api\n", manifest)
+func YAMLEqual(t testRunner, expected string, actual string, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	compareFormat(t, "YAML", decodeYAML, expected, actual, msg...)
+}
+
+// TOMLEqual asserts that two TOML strings are semantically equal. The formatting, comments and the order of keys are ignored.
+// Differences are reported with their path, like JSONEqual does.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.TOMLEqual(t, "[server]\nport = 8080\n", config)
+func TOMLEqual(t testRunner, expected string, actual string, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	compareFormat(t, "TOML", decodeTOML, expected, actual, msg...)
+}
+
+// XMLEqual asserts that two XML strings are semantically equal.
+// The order of attributes, whitespace around text, comments and namespace prefixes are ignored,
+// elements are compared by their namespace URI and local name.
+// Differences are reported with their path, where child elements are grouped by name and attributes start with "@".
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.XMLEqual(t, `<server port="8080" host="localhost"/>`, `<server host="localhost" port="8080"></server>`)
+func XMLEqual(t testRunner, expected string, actual string, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	compareFormat(t, "XML", decodeXML, expected, actual, msg...)
+}
+
+// compareFormat decodes two documents into canonical trees and reports their differences with jd.
+func compareFormat(t testRunner, format string, decode func(string) (any, error), expected, actual string, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	expectedValue, err := decode(expected)
+	if err != nil {
+		internal.Fail(t, fmt.Sprintf("The expected %s !!is invalid!!.", format), internal.Objects{parseErrorObject(expected, err)}, msg...)
+		return
+	}
+	actualValue, err := decode(actual)
+	if err != nil {
+		internal.Fail(t, fmt.Sprintf("The actual %s !!is invalid!!.", format), internal.Objects{parseErrorObject(actual, err)}, msg...)
+		return
+	}
+
+	a, err := jd.ReadJsonString(encodeJSON(expectedValue))
+	if err != nil {
+		internal.Fail(t, fmt.Sprintf("The expected %s !!could not be compared!!.", format), internal.NewObjectsSingleNamed("Error", err.Error()), msg...)
+		return
+	}
+	b, err := jd.ReadJsonString(encodeJSON(actualValue))
+	if err != nil {
+		internal.Fail(t, fmt.Sprintf("The actual %s !!could not be compared!!.", format), internal.NewObjectsSingleNamed("Error", err.Error()), msg...)
+		return
+	}
+
+	if d := a.Diff(b); len(d) > 0 {
+		internal.Fail(t, fmt.Sprintf("The %s documents !!are not equal!!.", format), internal.Objects{
+			{
+				Name:      "Difference",
+				NameStyle: pterm.NewStyle(pterm.FgYellow),
+				Data:      d.Render(),
+				Raw:       true,
+			},
+		}, msg...)
+	}
+}
+
+// decodeYAML decodes every document of a YAML stream into a list, so that the number of documents is compared too.
+func decodeYAML(s string) (any, error) {
+	decoder := yaml.NewDecoder(strings.NewReader(s))
+
+	documents := []any{}
+	for {
+		var document any
+		err := decoder.Decode(&document)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		documents = append(documents, canonicalKeys(document))
+	}
+
+	return documents, nil
+}
+
+// canonicalKeys converts maps with non-string keys, which YAML allows, to maps with string keys.
+func canonicalKeys(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, element := range v {
+			v[key] = canonicalKeys(element)
+		}
+	case map[any]any:
+		converted := make(map[string]any, len(v))
+		for key, element := range v {
+			converted[fmt.Sprint(key)] = canonicalKeys(element)
+		}
+		return converted
+	case []any:
+		for i, element := range v {
+			v[i] = canonicalKeys(element)
+		}
+	}
+
+	return value
+}
+
+func decodeTOML(s string) (any, error) {
+	var document map[string]any
+	if _, err := toml.Decode(s, &document); err != nil {
+		var parseErr toml.ParseError
+		if errors.As(err, &parseErr) {
+			return nil, fmt.Errorf("line %d, column %d: %s", parseErr.Position.Line, parseErr.Position.Col, parseErr.Message)
+		}
+		return nil, err
+	}
+
+	// Dates and times are compared by their TOML representation.
+	canonical, err := json.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeJSON(string(canonical))
+}
+
+// decodeXML decodes the root element of an XML document into a canonical tree.
+// Every element becomes a map, with attributes under "@name", text under "#text", and child elements grouped by name into lists.
+func decodeXML(s string) (any, error) {
+	decoder := xml.NewDecoder(strings.NewReader(s))
+
+	type element struct {
+		name     string
+		value    map[string]any
+		children map[string][]any
+		text     strings.Builder
+	}
+
+	var root map[string]any
+	var stack []*element
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			if root != nil && len(stack) == 0 {
+				line, column := decoder.InputPos()
+				return nil, fmt.Errorf("line %d, column %d: more than one root element", line, column)
+			}
+			e := &element{name: xmlName(token.Name), value: map[string]any{}, children: map[string][]any{}}
+			for _, attr := range token.Attr {
+				// Namespace declarations are already resolved into the names of elements and attributes.
+				if attr.Name.Space == "xmlns" || (attr.Name.Space == "" && attr.Name.Local == "xmlns") {
+					continue
+				}
+				e.value["@"+xmlName(attr.Name)] = attr.Value
+			}
+			stack = append(stack, e)
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text.Write(token)
+			}
+		case xml.EndElement:
+			e := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if text := strings.TrimSpace(e.text.String()); text != "" {
+				e.value["#text"] = text
+			}
+			for name, children := range e.children {
+				e.value[name] = children
+			}
+
+			if len(stack) == 0 {
+				root = map[string]any{e.name: e.value}
+			} else {
+				parent := stack[len(stack)-1]
+				parent.children[e.name] = append(parent.children[e.name], e.value)
+			}
+		}
+	}
+
+	if root == nil {
+		return nil, errors.New("the document has no root element")
+	}
+
+	return root, nil
+}
+
+func xmlName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+
+	return "{" + name.Space + "}" + name.Local
+}
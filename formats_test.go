@@ -0,0 +1,96 @@
+package assert_test
+
+import (
+	"testing"
+
+	. "github.com/chalk-ai/assert"
+)
+
+func TestYAMLEqual(t *testing.T) {
+	YAMLEqual(t, "kind: Service\nmetadata:\n  name: api\n  labels: {app: api, tier: web}\n",
+		"# comment\nmetadata:\n  labels:\n    tier: web\n    app: api\n  name: api\nkind: Service\n")
+	YAMLEqual(t, "a: 1\n---\nb: 2\n", "a: 1\n---\nb:   2\n")
+	YAMLEqual(t, "1: one\n", "1: one\n")
+}
+
+func TestYAMLEqual_fails(t *testing.T) {
+	var tm testMock
+	YAMLEqual(&tm, "metadata:\n  name: api\n", "metadata:\n  name: web\n")
+
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, `0,"metadata","name"`)
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		YAMLEqual(t, "a: 1\n---\nb: 2\n", "a: 1\n---\nb: 3\n")
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		YAMLEqual(t, "a: 1\n", "a: 1\n---\nb: 2\n")
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		YAMLEqual(t, "- a\n- b\n", "a\n---\nb\n")
+	})
+
+	tm = testMock{}
+	YAMLEqual(&tm, "a: 1\n", "a: [1\n")
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "actual YAML")
+	Contains(t, tm.ErrorMessage, "line")
+}
+
+func TestTOMLEqual(t *testing.T) {
+	TOMLEqual(t, "title = \"app\"\n[server]\nport = 8080\nhost = \"localhost\"\n",
+		"# comment\ntitle=\"app\"\n\n[server]\nhost = 'localhost'\nport = 8080\n")
+	TOMLEqual(t, "date = 2024-01-01T00:00:00Z\n", "date = 2024-01-01 00:00:00Z\n")
+}
+
+func TestTOMLEqual_fails(t *testing.T) {
+	var tm testMock
+	TOMLEqual(&tm, "[server]\nport = 8080\n", "[server]\nport = 9090\n")
+
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, `"server","port"`)
+
+	tm = testMock{}
+	TOMLEqual(&tm, "[server\n", "")
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "column")
+}
+
+func TestXMLEqual(t *testing.T) {
+	XMLEqual(t, `<server port="8080" host="localhost"/>`, `<server host="localhost" port="8080"></server>`)
+	XMLEqual(t, "<config>\n  <name>api</name>\n</config>", `<?xml version="1.0"?><!-- comment --><config><name> api </name></config>`)
+	XMLEqual(t,
+		`<a:root xmlns:a="urn:example"><a:item>1</a:item></a:root>`,
+		`<root xmlns="urn:example"><item>1</item></root>`)
+}
+
+func TestXMLEqual_fails(t *testing.T) {
+	var tm testMock
+	XMLEqual(&tm, `<server><port>8080</port></server>`, `<server><port>9090</port></server>`)
+
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, `"server","port",0,"#text"`)
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		XMLEqual(t, `<root xmlns="urn:a"><item/></root>`, `<root xmlns="urn:b"><item/></root>`)
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		XMLEqual(t, `<root a="1"/>`, `<root a="2"/>`)
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		XMLEqual(t, `<root><a/><a/></root>`, `<root><a/></root>`)
+	})
+
+	tm = testMock{}
+	XMLEqual(&tm, `<root>`, `<root/>`)
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "expected XML")
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		XMLEqual(t, `<root/>`, `<root/><root/>`)
+	})
+}
@@ -4,12 +4,16 @@ go 1.26.1
 
 require (
 	atomicgo.dev/assert v0.0.2
+	github.com/BurntSushi/toml v1.6.0
 	github.com/davecgh/go-spew v1.1.1
 	github.com/josephburnett/jd v1.9.2
 	github.com/klauspost/cpuid/v2 v2.3.0
 	github.com/lucsky/cuid v1.2.1
 	github.com/pterm/pterm v0.12.83
+	github.com/santhosh-tekuri/jsonschema/v6 v6.0.3
 	github.com/sergi/go-diff v1.4.0
+	golang.org/x/text v0.35.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -27,7 +31,5 @@ require (
 	golang.org/x/exp v0.0.0-20260312153236-7ab1446f8b90 // indirect
 	golang.org/x/sys v0.42.0 // indirect
 	golang.org/x/term v0.41.0 // indirect
-	golang.org/x/text v0.35.0 // indirect
 	gopkg.in/yaml.v2 v2.4.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -0,0 +1,165 @@
+package assert
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pterm/pterm"
+
+	"github.com/chalk-ai/assert/internal"
+)
+
+var ansiEscapeSequence = regexp.MustCompile(`\x1b\[[0-9;?]*[ -/]*[@-~]`)
+
+// RunGolden runs a command and compares its stdout, stderr and exit code with a golden file.
+// The golden file is stored in testdata/golden/<name>.golden next to the calling test file.
+// If it does not exist, it is created, otherwise it is validated, like with SnapshotCreateOrValidate.
+// To re-create a golden file, you can delete it.
+//
+// Before comparing, ANSI escape sequences are removed, \r\n is replaced with \n
+// and paths inside of the temporary directory are replaced with $TMPDIR.
+// A missing newline at the end of stdout or stderr is ignored.
+// Writers that are already set as cmd.Stdout or cmd.Stderr still receive the output.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	cmd := exec.Command("mycli", "--help")
+//	assert.RunGolden(t, t.Name(), cmd)
+func RunGolden(t testRunner, name string, cmd *exec.Cmd, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	goldenPath := path.Clean(getCurrentScriptDirectory() + "/testdata/golden/" + name + ".golden")
+
+	output, err := runForGolden(cmd)
+	if err != nil {
+		internal.Fail(t, "The command !!could not be run!!.", internal.Objects{
+			internal.NewObjectsSingleNamed("Command", cmd.String())[0],
+			internal.NewErrorChainObject("Error", err),
+		}, msg...)
+		return
+	}
+
+	content, err := os.ReadFile(goldenPath)
+	if errors.Is(err, os.ErrNotExist) {
+		if err := writeGoldenFile(goldenPath, output); err != nil {
+			internal.Fail(t, "The golden file !!could not be created!!.", internal.Objects{
+				internal.NewErrorChainObject("Error", err),
+			}, msg...)
+		}
+		return
+	} else if err != nil {
+		internal.Fail(t, "The golden file !!could not be read!!.", internal.Objects{
+			internal.NewErrorChainObject("Error", err),
+		}, msg...)
+		return
+	}
+
+	// Formatting and parsing the output normalizes it the same way as the golden file.
+	expected := internal.ParseArchive(string(content))
+	actual := internal.ParseArchive(internal.FormatArchive(output))
+	var objects internal.Objects
+	for _, f := range actual.Files {
+		expectedData, _ := expected.File(f.Name)
+		if expectedData != f.Data {
+			object := internal.NewLineDiffObject(strings.TrimSuffix(expectedData, "\n"), strings.TrimSuffix(f.Data, "\n"))
+			object.Name = "Difference in " + f.Name
+			objects = append(objects, object)
+		}
+	}
+
+	if len(objects) > 0 {
+		internal.Fail(t, fmt.Sprintf("The output of the command !!does not match!! the golden file %q.", name), append(internal.Objects{
+			{
+				Name:      "Golden File",
+				NameStyle: pterm.NewStyle(pterm.FgLightYellow),
+				Data:      goldenPath + "\n",
+				Raw:       true,
+			},
+		}, objects...), msg...)
+	}
+}
+
+// runForGolden runs a command and returns its normalized output as an archive.
+// A non-zero exit code is not an error.
+func runForGolden(cmd *exec.Cmd) (internal.Archive, error) {
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = teeWriter(cmd.Stdout, &stdout)
+	cmd.Stderr = teeWriter(cmd.Stderr, &stderr)
+
+	exitCode := 0
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) {
+			return internal.Archive{}, err
+		}
+		exitCode = exitErr.ExitCode()
+	}
+
+	return internal.Archive{
+		Comment: normalizeGoldenOutput(strings.Join(cmd.Args, " ")) + "\n",
+		Files: []internal.ArchiveFile{
+			{Name: "exit code", Data: strconv.Itoa(exitCode) + "\n"},
+			{Name: "stdout", Data: normalizeGoldenOutput(stdout.String())},
+			{Name: "stderr", Data: normalizeGoldenOutput(stderr.String())},
+		},
+	}, nil
+}
+
+func teeWriter(existing io.Writer, buf *bytes.Buffer) io.Writer {
+	if existing == nil {
+		return buf
+	}
+
+	return io.MultiWriter(existing, buf)
+}
+
+// normalizeGoldenOutput removes everything from an output, that changes between runs or machines.
+func normalizeGoldenOutput(s string) string {
+	s = ansiEscapeSequence.ReplaceAllString(s, "")
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+
+	for _, dir := range tempDirs() {
+		// The first element below the temporary directory usually contains a random suffix,
+		// followed by a counter for directories created by testing.T.TempDir.
+		pattern := regexp.MustCompile(regexp.QuoteMeta(dir) + `(?:/[^/\s"':]+(?:/\d{3}\b)?)?`)
+		s = pattern.ReplaceAllString(s, "$$TMPDIR")
+	}
+
+	return s
+}
+
+// tempDirs returns the temporary directory, and the path it resolves to, if it is a symlink.
+func tempDirs() []string {
+	dir := filepath.ToSlash(filepath.Clean(os.TempDir()))
+	dirs := []string{dir}
+	if resolved, err := filepath.EvalSymlinks(dir); err == nil && filepath.ToSlash(resolved) != dir {
+		dirs = append([]string{filepath.ToSlash(resolved)}, dirs...)
+	}
+
+	return dirs
+}
+
+func writeGoldenFile(goldenPath string, archive internal.Archive) error {
+	if err := os.MkdirAll(path.Dir(goldenPath), 0755); err != nil {
+		return fmt.Errorf("creating golden file failed: %w", err)
+	}
+
+	if err := os.WriteFile(goldenPath, []byte(internal.FormatArchive(archive)), 0644); err != nil {
+		return fmt.Errorf("creating golden file failed: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package assert_test
+
+import (
+	"os/exec"
+	"testing"
+
+	. "github.com/chalk-ai/assert"
+)
+
+func shellCommand(t *testing.T, script string, args ...string) *exec.Cmd {
+	t.Helper()
+
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh is not available")
+	}
+
+	return exec.Command("sh", append([]string{"-c", script, "sh"}, args...)...)
+}
+
+func TestRunGolden(t *testing.T) {
+	cmd := shellCommand(t, `echo "hello from $1"; printf '\033[31mwarning\033[0m\r\n' >&2; exit 3`, t.TempDir()+"/config.yaml")
+	RunGolden(t, t.Name(), cmd)
+}
+
+func TestRunGolden_fails(t *testing.T) {
+	var tm testMock
+	RunGolden(&tm, t.Name(), shellCommand(t, `echo changed; echo unchanged >&2`))
+
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "Difference in stdout")
+	NotContains(t, tm.ErrorMessage, "Difference in stderr")
+	NotContains(t, tm.ErrorMessage, "Difference in exit code")
+}
+
+func TestRunGolden_exit_code_fails(t *testing.T) {
+	var tm testMock
+	RunGolden(&tm, "TestRunGolden_fails", shellCommand(t, `echo original; echo unchanged >&2; exit 1`))
+
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "Difference in exit code")
+	NotContains(t, tm.ErrorMessage, "Difference in stdout")
+}
+
+func TestRunGolden_command_not_found(t *testing.T) {
+	var tm testMock
+	RunGolden(&tm, t.Name(), exec.Command("assert-command-that-does-not-exist"))
+
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "could not be run")
+	NoFileExists(t, "testdata/golden/TestRunGolden_command_not_found.golden")
+}
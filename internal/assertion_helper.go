@@ -7,14 +7,15 @@ import (
 	"io"
 	"os"
 	"reflect"
-	"regexp"
+	"runtime/debug"
 	"strings"
 	"time"
 )
 
 // CompletesIn returns if a function completes in a specific time.
+// The channel is buffered, so that the goroutine can finish even if the function outlives the duration.
 func CompletesIn(duration time.Duration, f func()) bool {
-	done := make(chan bool)
+	done := make(chan bool, 1)
 	go func() {
 		f()
 		done <- true
@@ -28,6 +29,44 @@ func CompletesIn(duration time.Duration, f func()) bool {
 	}
 }
 
+// RecoverPanic calls f and returns if it panicked, the recovered value and the stack trace of the panic.
+// The stack trace is trimmed to the frames between the panic and the call of f.
+func RecoverPanic(f func()) (panicked bool, value any, stack string) {
+	defer func() {
+		if r := recover(); r != nil {
+			panicked = true
+			value = r
+			stack = trimPanicStack(string(debug.Stack()))
+		}
+	}()
+
+	f()
+	return
+}
+
+// trimPanicStack removes the frames of the recovering code and of the callers of RecoverPanic from a stack trace.
+func trimPanicStack(stack string) string {
+	lines := strings.Split(strings.TrimSpace(stack), "\n")
+	if len(lines) > 0 && strings.HasPrefix(lines[0], "goroutine ") {
+		lines = lines[1:]
+	}
+
+	var frames []string
+	for i := 0; i+1 < len(lines); i += 2 {
+		function := lines[i]
+		if strings.HasPrefix(function, "panic(") {
+			frames = frames[:0]
+			continue
+		}
+		if strings.HasPrefix(function, "github.com/chalk-ai/assert/internal.RecoverPanic(") {
+			break
+		}
+		frames = append(frames, function+"\n"+lines[i+1])
+	}
+
+	return strings.Join(frames, "\n") + "\n"
+}
+
 // IsEqual checks if two objects are equal.
 func IsEqual(expected any, actual any) bool {
 	if expected == nil || actual == nil {
@@ -69,7 +108,8 @@ func HasEqualValues(expected any, actual any) bool {
 	return false
 }
 
-// DoesContain checks that ab objects contains an element.
+// DoesContain checks that an object contains an element.
+// Strings are checked for a substring, maps for a key and everything else for an element.
 func DoesContain(object, element any) bool {
 	objectValue := reflect.ValueOf(object)
 	objectKind := reflect.TypeOf(object).Kind()
@@ -78,6 +118,11 @@ func DoesContain(object, element any) bool {
 	case reflect.String:
 		return strings.Contains(objectValue.String(), reflect.ValueOf(element).String())
 	case reflect.Map:
+		key := reflect.ValueOf(element)
+		if !key.IsValid() || !key.Type().AssignableTo(objectValue.Type().Key()) {
+			return false
+		}
+		return objectValue.MapIndex(key).IsValid()
 	default:
 		for i := 0; i < objectValue.Len(); i++ {
 			if IsEqual(objectValue.Index(i).Interface(), element) {
@@ -175,17 +220,22 @@ func AssertRegexpHelper(t testRunner, regex any, txt any, shouldMatch bool, msg
 		test.Helper()
 	}
 
-	regexString := fmt.Sprint(regex)
-	txtString := fmt.Sprint(txt)
-	match, _ := regexp.MatchString(regexString, txtString)
-	if shouldMatch != match {
-		failText := "!!does not match!! the string."
-		if !shouldMatch {
-			failText = "!!does match!! the string, but !!should not!!."
-		}
-		Fail(t, "The regex pattern "+failText, Objects{
-			NewObjectsSingleNamed("Regex Pattern", regexString+"\n")[0],
+	re, err := CompileRegexp(regex)
+	if err != nil {
+		Fail(t, "The regex pattern !!is invalid!!.", NewInvalidRegexpObjects(regex, err), msg...)
+		return
+	}
+
+	txtString := RegexpText(txt)
+	match := re.MatchString(txtString)
+	if shouldMatch && !match {
+		Fail(t, "The regex pattern !!does not match!! the string.", NewRegexpMismatchObjects(re, txtString), msg...)
+	} else if !shouldMatch && match {
+		loc := re.FindStringIndex(txtString)
+		Fail(t, "The regex pattern !!does match!! the string, but !!should not!!.", Objects{
+			NewObjectsSingleNamed("Regex Pattern", re.String()+"\n")[0],
 			NewObjectsSingleNamed("String", txtString+"\n")[0],
+			NewObjectsSingleNamed("Match", fmt.Sprintf("%q at offset %d\n", txtString[loc[0]:loc[1]], loc[0]))[0],
 		}, msg...)
 	}
 }
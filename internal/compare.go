@@ -0,0 +1,413 @@
+package internal
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Matcher mirrors the match.Matcher interface, so that the comparison engine can evaluate
+// matchers which are embedded inside of expected values without importing the match package.
+type Matcher interface {
+	Match(actual any) (bool, string)
+}
+
+// Difference describes a single mismatch between an expected and an actual value.
+type Difference struct {
+	Path     string
+	Expected any
+	Actual   any
+	Reason   string
+}
+
+// CompareOptions customizes how Compare treats values.
+type CompareOptions struct {
+	// IgnoredFields contains struct field names or paths that are not compared.
+	// A plain name like "ID" ignores the field at every depth, a dotted path like "Meta.ID" only the field at that path.
+	// Slice indices and map keys are not part of a dotted path, but a full path like "Items[0].ID" can be used too.
+	IgnoredFields []string
+	// IgnoreUnexported skips unexported struct fields.
+	IgnoreUnexported bool
+	// IgnoreOrder compares slices and arrays regardless of the order of their elements.
+	IgnoreOrder bool
+	// NilEqualsEmpty treats nil and empty slices and maps as equal.
+	NilEqualsEmpty bool
+	// FloatEpsilon is the maximum absolute difference between two floats, which are still considered equal.
+	FloatEpsilon float64
+	// TimeEqual compares time.Time values with time.Time.Equal, which ignores the location and monotonic clock.
+	TimeEqual bool
+	// Partial only compares the parts of the expected value which are set.
+	// Struct fields with a zero value, as well as nil pointers, slices, maps and interfaces, match every actual value.
+	// Maps only have to contain the keys of the expected map.
+	Partial bool
+	// FieldMatchers contains matchers for struct field names or paths, which are used instead of the expected value.
+	// Keys are interpreted like IgnoredFields.
+	FieldMatchers map[string]Matcher
+}
+
+// Compare recursively compares two values and returns every difference it finds.
+// Matchers that are part of the expected value are evaluated against the actual value at the same path.
+func Compare(expected, actual any, opts CompareOptions) []Difference {
+	c := comparer{opts: opts, visited: make(map[visit]bool)}
+	c.compare("", "", reflect.ValueOf(expected), reflect.ValueOf(actual))
+
+	return c.diffs
+}
+
+type visit struct {
+	expected uintptr
+	actual   uintptr
+	typ      reflect.Type
+}
+
+type comparer struct {
+	opts    CompareOptions
+	diffs   []Difference
+	visited map[visit]bool
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func (c *comparer) report(path string, expected, actual reflect.Value, reason string) {
+	c.diffs = append(c.diffs, Difference{
+		Path:     path,
+		Expected: interfaceOf(expected),
+		Actual:   interfaceOf(actual),
+		Reason:   reason,
+	})
+}
+
+// compare compares two values. fieldPath is the path without slice indices and map keys, used to look up field options.
+func (c *comparer) compare(path, fieldPath string, expected, actual reflect.Value) {
+	if m, ok := matcherOf(expected); ok {
+		if matched, reason := m.Match(interfaceOf(actual)); !matched {
+			c.diffs = append(c.diffs, Difference{Path: path, Expected: m, Actual: interfaceOf(actual), Reason: reason})
+		}
+		return
+	}
+
+	if c.opts.Partial && isUnset(expected) {
+		return
+	}
+
+	if expected.IsValid() && expected.Kind() == reflect.Interface {
+		expected = expected.Elem()
+	}
+	if actual.IsValid() && actual.Kind() == reflect.Interface {
+		actual = actual.Elem()
+	}
+
+	if !expected.IsValid() || !actual.IsValid() {
+		if expected.IsValid() != actual.IsValid() {
+			c.report(path, expected, actual, "only one of the values is nil")
+		}
+		return
+	}
+
+	if expected.Type() != actual.Type() {
+		c.report(path, expected, actual, fmt.Sprintf("types differ: %s != %s", expected.Type(), actual.Type()))
+		return
+	}
+
+	if c.opts.TimeEqual && expected.Type() == timeType && expected.CanInterface() && actual.CanInterface() {
+		if !expected.Interface().(time.Time).Equal(actual.Interface().(time.Time)) {
+			c.report(path, expected, actual, "times are not equal")
+		}
+		return
+	}
+
+	switch expected.Kind() {
+	case reflect.Pointer:
+		if expected.IsNil() || actual.IsNil() {
+			if expected.IsNil() != actual.IsNil() {
+				c.report(path, expected, actual, "only one of the pointers is nil")
+			}
+			return
+		}
+		if c.seen(expected, actual) {
+			return
+		}
+		c.compare(path, fieldPath, expected.Elem(), actual.Elem())
+	case reflect.Struct:
+		c.compareStruct(path, fieldPath, expected, actual)
+	case reflect.Slice:
+		if expected.IsNil() != actual.IsNil() && !(c.opts.NilEqualsEmpty && expected.Len() == 0 && actual.Len() == 0) {
+			c.report(path, expected, actual, "only one of the slices is nil")
+			return
+		}
+		if c.seen(expected, actual) {
+			return
+		}
+		c.compareSequence(path, fieldPath, expected, actual)
+	case reflect.Array:
+		c.compareSequence(path, fieldPath, expected, actual)
+	case reflect.Map:
+		if expected.IsNil() != actual.IsNil() && !(c.opts.NilEqualsEmpty && expected.Len() == 0 && actual.Len() == 0) {
+			c.report(path, expected, actual, "only one of the maps is nil")
+			return
+		}
+		if c.seen(expected, actual) {
+			return
+		}
+		c.compareMap(path, fieldPath, expected, actual)
+	case reflect.Func:
+		if !expected.IsNil() || !actual.IsNil() {
+			c.report(path, expected, actual, "functions are only equal if both are nil")
+		}
+	case reflect.Chan, reflect.UnsafePointer:
+		if expected.Pointer() != actual.Pointer() {
+			c.report(path, expected, actual, "values point to different addresses")
+		}
+	case reflect.Float32, reflect.Float64:
+		if c.opts.FloatEpsilon > 0 {
+			if delta := math.Abs(expected.Float() - actual.Float()); !(delta <= c.opts.FloatEpsilon) {
+				c.report(path, expected, actual, fmt.Sprintf("difference %g is greater than epsilon %g", delta, c.opts.FloatEpsilon))
+			}
+		} else if expected.Float() != actual.Float() {
+			c.report(path, expected, actual, "values are not equal")
+		}
+	default:
+		if !basicEqual(expected, actual) {
+			c.report(path, expected, actual, "values are not equal")
+		}
+	}
+}
+
+func (c *comparer) compareStruct(path, fieldPath string, expected, actual reflect.Value) {
+	for i := range expected.NumField() {
+		field := expected.Type().Field(i)
+		if c.opts.IgnoreUnexported && !field.IsExported() {
+			continue
+		}
+
+		p, fp := path+"."+field.Name, field.Name
+		if fieldPath != "" {
+			fp = fieldPath + "." + field.Name
+		}
+		if matchesField(c.opts.IgnoredFields, field.Name, p, fp) || (c.opts.Partial && expected.Field(i).IsZero()) {
+			continue
+		}
+
+		if m, ok := c.fieldMatcher(field.Name, p, fp); ok {
+			if matched, reason := m.Match(interfaceOf(actual.Field(i))); !matched {
+				c.diffs = append(c.diffs, Difference{Path: p, Expected: m, Actual: interfaceOf(actual.Field(i)), Reason: reason})
+			}
+			continue
+		}
+
+		c.compare(p, fp, expected.Field(i), actual.Field(i))
+	}
+}
+
+func (c *comparer) fieldMatcher(name, path, fieldPath string) (Matcher, bool) {
+	for pattern, m := range c.opts.FieldMatchers {
+		if matchesField([]string{pattern}, name, path, fieldPath) {
+			return m, true
+		}
+	}
+
+	return nil, false
+}
+
+func matchesField(patterns []string, name, path, fieldPath string) bool {
+	for _, pattern := range patterns {
+		if pattern == fieldPath || pattern == FormatPath(path) {
+			return true
+		}
+		if !strings.ContainsAny(pattern, ".[") && pattern == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *comparer) compareSequence(path, fieldPath string, expected, actual reflect.Value) {
+	if c.opts.IgnoreOrder {
+		c.compareUnordered(path, fieldPath, expected, actual)
+		return
+	}
+
+	for i := range max(expected.Len(), actual.Len()) {
+		elementPath := fmt.Sprintf("%s[%d]", path, i)
+		switch {
+		case i >= actual.Len():
+			c.report(elementPath, expected.Index(i), reflect.Value{}, "element is missing")
+		case i >= expected.Len():
+			c.report(elementPath, reflect.Value{}, actual.Index(i), "unexpected element")
+		default:
+			c.compare(elementPath, fieldPath, expected.Index(i), actual.Index(i))
+		}
+	}
+}
+
+// compareUnordered finds a one-to-one assignment between expected and actual elements with augmenting paths,
+// so that overlapping matchers do not cause a mismatch, when a valid assignment exists.
+func (c *comparer) compareUnordered(path, fieldPath string, expected, actual reflect.Value) {
+	matches := make([][]bool, expected.Len())
+	for i := range matches {
+		matches[i] = make([]bool, actual.Len())
+		for j := range actual.Len() {
+			sub := comparer{opts: c.opts, visited: make(map[visit]bool)}
+			sub.compare(path, fieldPath, expected.Index(i), actual.Index(j))
+			matches[i][j] = len(sub.diffs) == 0
+		}
+	}
+
+	assigned := make([]int, actual.Len())
+	for j := range assigned {
+		assigned[j] = -1
+	}
+	var augment func(i int, tried []bool) bool
+	augment = func(i int, tried []bool) bool {
+		for j := range assigned {
+			if !matches[i][j] || tried[j] {
+				continue
+			}
+			tried[j] = true
+			if assigned[j] == -1 || augment(assigned[j], tried) {
+				assigned[j] = i
+				return true
+			}
+		}
+		return false
+	}
+
+	for i := range matches {
+		if !augment(i, make([]bool, actual.Len())) {
+			c.report(fmt.Sprintf("%s[%d]", path, i), expected.Index(i), reflect.Value{}, "element is missing")
+		}
+	}
+
+	for j, i := range assigned {
+		if i == -1 {
+			c.report(fmt.Sprintf("%s[%d]", path, j), reflect.Value{}, actual.Index(j), "unexpected element")
+		}
+	}
+}
+
+func (c *comparer) compareMap(path, fieldPath string, expected, actual reflect.Value) {
+	for _, key := range sortedKeys(expected) {
+		keyPath := fmt.Sprintf("%s[%s]", path, FormatValue(interfaceOf(key)))
+		actualValue := actual.MapIndex(key)
+		if !actualValue.IsValid() {
+			c.report(keyPath, expected.MapIndex(key), reflect.Value{}, "key is missing")
+			continue
+		}
+		c.compare(keyPath, fieldPath, expected.MapIndex(key), actualValue)
+	}
+
+	if c.opts.Partial {
+		return
+	}
+
+	for _, key := range sortedKeys(actual) {
+		if !expected.MapIndex(key).IsValid() {
+			c.report(fmt.Sprintf("%s[%s]", path, FormatValue(interfaceOf(key))), reflect.Value{}, actual.MapIndex(key), "unexpected key")
+		}
+	}
+}
+
+func (c *comparer) seen(expected, actual reflect.Value) bool {
+	v := visit{expected: expected.Pointer(), actual: actual.Pointer(), typ: expected.Type()}
+	if c.visited[v] {
+		return true
+	}
+	c.visited[v] = true
+
+	return false
+}
+
+// isUnset reports if a value is nil, and therefore not set in a partial comparison.
+func isUnset(v reflect.Value) bool {
+	if !v.IsValid() {
+		return true
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
+
+func basicEqual(expected, actual reflect.Value) bool {
+	switch expected.Kind() {
+	case reflect.Bool:
+		return expected.Bool() == actual.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return expected.Int() == actual.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return expected.Uint() == actual.Uint()
+	case reflect.Complex64, reflect.Complex128:
+		return expected.Complex() == actual.Complex()
+	case reflect.String:
+		return expected.String() == actual.String()
+	default:
+		return false
+	}
+}
+
+func matcherOf(v reflect.Value) (Matcher, bool) {
+	if !v.IsValid() || !v.CanInterface() {
+		return nil, false
+	}
+	if v.Kind() == reflect.Interface && v.IsNil() {
+		return nil, false
+	}
+
+	m, ok := v.Interface().(Matcher)
+	return m, ok
+}
+
+// interfaceOf returns the value as an interface. Unexported values are formatted, as they can not be accessed directly.
+func interfaceOf(v reflect.Value) any {
+	if !v.IsValid() {
+		return nil
+	}
+	if !v.CanInterface() {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return v.Interface()
+}
+
+func sortedKeys(m reflect.Value) []reflect.Value {
+	keys := m.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return FormatValue(interfaceOf(keys[i])) < FormatValue(interfaceOf(keys[j]))
+	})
+
+	return keys
+}
+
+// FormatValue returns a short, single line representation of a value, for use in failure messages.
+func FormatValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "nil"
+	case string:
+		return fmt.Sprintf("%q", v)
+	case error:
+		return fmt.Sprintf("error(%q)", v.Error())
+	case fmt.Stringer:
+		if rv := reflect.ValueOf(v); rv.Kind() != reflect.Pointer || !rv.IsNil() {
+			return v.String()
+		}
+	}
+
+	return fmt.Sprintf("%#v", v)
+}
+
+// FormatPath returns a readable representation of a difference path.
+func FormatPath(path string) string {
+	if path == "" {
+		return "(root)"
+	}
+
+	return strings.TrimPrefix(path, ".")
+}
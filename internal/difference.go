@@ -47,6 +47,25 @@ func getDifference(a, b any, raw ...bool) string {
 	diffs = dmp.DiffCleanupEfficiency(diffs)
 	diffs = dmp.DiffCleanupSemanticLossless(diffs)
 
+	return printDiffs(aString, bString, diffs)
+}
+
+// NewLineDiffObject returns a diff of two texts, which compares whole lines, so that changed lines are aligned by their line number.
+func NewLineDiffObject(expected, actual string) Object {
+	dmp := diffmatchpatch.New()
+	a, b, lines := dmp.DiffLinesToChars(expected, actual)
+	diffs := dmp.DiffCharsToLines(dmp.DiffMain(a, b, false), lines)
+
+	return Object{
+		Name:      "Difference",
+		NameStyle: pterm.NewStyle(pterm.FgYellow),
+		Data:      printDiffs(expected, actual, diffs),
+		Raw:       true,
+	}
+}
+
+// printDiffs prints the diffs of two strings line by line, with the line numbers of both strings.
+func printDiffs(aString, bString string, diffs []diffmatchpatch.Diff) string {
 	maxNewlines := math.Max(float64(strings.Count(aString, "\n")), float64(strings.Count(bString, "\n"))) + 1
 
 	d := &diffPrinter{
@@ -0,0 +1,65 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pterm/pterm"
+)
+
+// maxErrorChainDepth limits the rendered depth of an error tree, in case of cyclic Unwrap implementations.
+const maxErrorChainDepth = 32
+
+// RenderErrorChain renders an error and every error it wraps as a tree.
+// Both Unwrap() error and Unwrap() []error, as used by errors.Join, are followed.
+func RenderErrorChain(err error) string {
+	if err == nil {
+		return "<nil>\n"
+	}
+
+	var sb strings.Builder
+	renderError(&sb, err, "", "", 0)
+
+	return sb.String()
+}
+
+func renderError(sb *strings.Builder, err error, prefix, childPrefix string, depth int) {
+	sb.WriteString(fmt.Sprintf("%s%T: %q\n", prefix, err, err.Error()))
+	if depth >= maxErrorChainDepth {
+		sb.WriteString(childPrefix + "└── ...\n")
+		return
+	}
+
+	var children []error
+	switch e := err.(type) {
+	case interface{ Unwrap() error }:
+		if child := e.Unwrap(); child != nil {
+			children = []error{child}
+		}
+	case interface{ Unwrap() []error }:
+		for _, child := range e.Unwrap() {
+			if child != nil {
+				children = append(children, child)
+			}
+		}
+	}
+
+	for i, child := range children {
+		if i == len(children)-1 {
+			renderError(sb, child, childPrefix+"└── ", childPrefix+"    ", depth+1)
+		} else {
+			renderError(sb, child, childPrefix+"├── ", childPrefix+"│   ", depth+1)
+		}
+	}
+}
+
+// NewErrorChainObject returns an object that renders the tree of an error.
+func NewErrorChainObject(name string, err error) Object {
+	return Object{
+		Name:      name,
+		NameStyle: pterm.NewStyle(pterm.FgLightRed, pterm.Bold),
+		Data:      RenderErrorChain(err),
+		DataStyle: pterm.NewStyle(pterm.FgRed),
+		Raw:       true,
+	}
+}
@@ -1,8 +1,10 @@
 package internal
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/pterm/pterm"
@@ -87,6 +89,46 @@ func NewObjectsSingleNamed(name string, obj any) Objects {
 	}
 }
 
+// NewObjectsDifferences returns one object per difference, named by the path at which the difference was found.
+func NewObjectsDifferences(diffs []Difference) Objects {
+	objects := make(Objects, 0, len(diffs))
+	for _, d := range diffs {
+		var data strings.Builder
+		if _, ok := d.Expected.(Matcher); !ok {
+			data.WriteString(pterm.FgGreen.Sprint("Expected: "+FormatValue(d.Expected)) + "\n")
+		}
+		data.WriteString(pterm.FgRed.Sprint("Actual:   "+FormatValue(d.Actual)) + "\n")
+		if d.Reason != "" {
+			data.WriteString(d.Reason + "\n")
+		}
+
+		objects = append(objects, Object{
+			Name:      FormatPath(d.Path),
+			NameStyle: pterm.NewStyle(pterm.FgYellow),
+			Data:      data.String(),
+			Raw:       true,
+		})
+	}
+
+	return objects
+}
+
+// NewTableObject returns an object that renders the rows as a table below the header.
+func NewTableObject(name string, header []string, rows [][]string) Object {
+	data := append([][]string{header}, rows...)
+	table, err := pterm.DefaultTable.WithHasHeader().WithData(data).Srender()
+	if err != nil {
+		table = fmt.Sprint(data)
+	}
+
+	return Object{
+		Name:      name,
+		NameStyle: pterm.NewStyle(pterm.FgYellow),
+		Data:      table + "\n",
+		Raw:       true,
+	}
+}
+
 func ModifyWrappedText(text, wrappingString string, modifier func(wrappedText string) string) string {
 	r := regexp.MustCompile(wrappingString + "(.*?)" + wrappingString)
 
@@ -128,7 +170,7 @@ func FailS(message string, objects Objects, args ...any) string {
 		}
 		message += "\n" + v.NameStyle.Add(*pterm.NewStyle(pterm.Bold)).Sprint(v.Name+":") + "\n"
 		if !v.Raw {
-			message += v.DataStyle.Sprint(spew.Sdump(v.Data))
+			message += v.DataStyle.Sprint(dump(v.Data))
 		} else {
 			message += v.DataStyle.Sprint(v.Data)
 		}
@@ -155,6 +197,23 @@ func FailS(message string, objects Objects, args ...any) string {
 	return message
 }
 
+// dump formats data for the fail message. Times and durations are printed in a readable form instead of their internals.
+func dump(data any) string {
+	switch data := data.(type) {
+	case time.Time:
+		return FormatTime(data) + "\n"
+	case time.Duration:
+		return data.String() + "\n"
+	}
+
+	return spew.Sdump(data)
+}
+
+// FormatTime formats a time with its location, without the monotonic clock reading.
+func FormatTime(t time.Time) string {
+	return t.Round(0).Format("2006-01-02 15:04:05.999999999 -0700 MST")
+}
+
 func Fail(t testRunner, message string, objects Objects, args ...any) {
 	if test, ok := t.(helper); ok {
 		test.Helper()
@@ -0,0 +1,93 @@
+package internal
+
+import (
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+)
+
+// CompileRegexp compiles a pattern, which can be a *regexp.Regexp, a string or anything that can be printed with fmt.Sprint.
+func CompileRegexp(regex any) (*regexp.Regexp, error) {
+	switch regex := regex.(type) {
+	case *regexp.Regexp:
+		if regex == nil {
+			return nil, fmt.Errorf("the regexp is nil")
+		}
+		return regex, nil
+	default:
+		return regexp.Compile(fmt.Sprint(regex))
+	}
+}
+
+// RegexpText converts the text an assertion matches against to a string. Byte slices are converted directly.
+func RegexpText(txt any) string {
+	if b, ok := txt.([]byte); ok {
+		return string(b)
+	}
+
+	return fmt.Sprint(txt)
+}
+
+// NewInvalidRegexpObjects returns the objects that describe a pattern, which could not be compiled.
+func NewInvalidRegexpObjects(regex any, err error) Objects {
+	return Objects{
+		NewObjectsSingleNamed("Regex Pattern", fmt.Sprint(regex)+"\n")[0],
+		NewObjectsSingleNamed("Error", err.Error()+"\n")[0],
+	}
+}
+
+// NewRegexpMismatchObjects returns the objects that describe a pattern, which does not match a string.
+// If a prefix of the pattern matches, the longest one and the text it matched are added, to show where the match diverged.
+func NewRegexpMismatchObjects(re *regexp.Regexp, txt string) Objects {
+	objects := Objects{
+		NewObjectsSingleNamed("Regex Pattern", re.String()+"\n")[0],
+		NewObjectsSingleNamed("String", txt+"\n")[0],
+	}
+
+	prefix, loc := longestMatchingPrefix(re, txt)
+	if prefix != "" {
+		objects = append(objects,
+			NewObjectsSingleNamed("Longest Matching Prefix", prefix+"\n")[0],
+			NewObjectsSingleNamed("Matched Text", fmt.Sprintf("%q at offset %d\n", txt[loc[0]:loc[1]], loc[0]))[0],
+		)
+	}
+
+	return objects
+}
+
+// longestMatchingPrefix returns the longest prefix of the top-level sequence of a pattern, which matches txt.
+// Literals are split into single characters, so that a prefix can end inside of them.
+func longestMatchingPrefix(re *regexp.Regexp, txt string) (string, []int) {
+	parsed, err := syntax.Parse(re.String(), syntax.Perl)
+	if err != nil {
+		return "", nil
+	}
+
+	var pieces []*syntax.Regexp
+	subs := []*syntax.Regexp{parsed}
+	if parsed.Op == syntax.OpConcat {
+		subs = parsed.Sub
+	}
+	for _, sub := range subs {
+		if sub.Op != syntax.OpLiteral {
+			pieces = append(pieces, sub)
+			continue
+		}
+		for _, r := range sub.Rune {
+			pieces = append(pieces, &syntax.Regexp{Op: syntax.OpLiteral, Flags: sub.Flags, Rune: []rune{r}})
+		}
+	}
+
+	for n := len(pieces) - 1; n > 0; n-- {
+		prefix := (&syntax.Regexp{Op: syntax.OpConcat, Sub: pieces[:n]}).String()
+		prefixRe, err := regexp.Compile(prefix)
+		if err != nil {
+			continue
+		}
+		if loc := prefixRe.FindStringIndex(txt); loc != nil {
+			return prefix, loc
+		}
+	}
+
+	return "", nil
+}
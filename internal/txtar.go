@@ -0,0 +1,88 @@
+package internal
+
+import (
+	"strings"
+)
+
+// Archive is a collection of named files, stored in the txtar format.
+// Every file starts with a marker line "-- name --", everything before the first marker is a comment.
+type Archive struct {
+	Comment string
+	Files   []ArchiveFile
+}
+
+// ArchiveFile is a single file of an Archive.
+type ArchiveFile struct {
+	Name string
+	Data string
+}
+
+// FormatArchive returns the txtar representation of an archive.
+// A missing newline at the end of the comment or a file is added, as the format cannot represent it.
+func FormatArchive(a Archive) string {
+	var sb strings.Builder
+	sb.WriteString(withFinalNewline(a.Comment))
+	for _, f := range a.Files {
+		sb.WriteString("-- " + f.Name + " --\n")
+		sb.WriteString(withFinalNewline(f.Data))
+	}
+
+	return sb.String()
+}
+
+// ParseArchive parses a txtar archive. Line endings are normalized to \n.
+func ParseArchive(data string) Archive {
+	var a Archive
+	var current *ArchiveFile
+	var sb strings.Builder
+
+	flush := func() {
+		if current == nil {
+			a.Comment = sb.String()
+		} else {
+			current.Data = sb.String()
+			a.Files = append(a.Files, *current)
+		}
+		sb.Reset()
+	}
+
+	for _, line := range strings.SplitAfter(strings.ReplaceAll(data, "\r\n", "\n"), "\n") {
+		if name, ok := archiveMarker(line); ok {
+			flush()
+			current = &ArchiveFile{Name: name}
+			continue
+		}
+		sb.WriteString(line)
+	}
+	flush()
+
+	return a
+}
+
+// File returns the data of the file with the given name and if it exists.
+func (a Archive) File(name string) (string, bool) {
+	for _, f := range a.Files {
+		if f.Name == name {
+			return f.Data, true
+		}
+	}
+
+	return "", false
+}
+
+func archiveMarker(line string) (string, bool) {
+	line = strings.TrimSuffix(line, "\n")
+	if !strings.HasPrefix(line, "-- ") || !strings.HasSuffix(line, " --") || len(line) < len("-- x --") {
+		return "", false
+	}
+
+	return strings.TrimSpace(line[3 : len(line)-3]), true
+}
+
+func withFinalNewline(s string) string {
+	if s == "" || strings.HasSuffix(s, "\n") {
+		return s
+	}
+
+	return s + "\n"
+}
@@ -0,0 +1,372 @@
+package assert
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	jd "github.com/josephburnett/jd/lib"
+	"github.com/pterm/pterm"
+
+	"github.com/chalk-ai/assert/internal"
+)
+
+// JSONOption customizes the comparison of JSONEqual, JSONContains and JSONEqualValue.
+type JSONOption func(opts *jsonOptions)
+
+type jsonOptions struct {
+	ignored [][]string
+	sets    [][]string
+}
+
+// IgnoreJSONPaths skips the values at the JSON pointers when comparing.
+// A "*" segment matches every key of an object or every element of an array.
+//
+// Example:
+//
+//	assert.JSONEqual(t, expected, actual, assert.IgnoreJSONPaths("/meta/requestId", "/items/*/createdAt"))
+func IgnoreJSONPaths(pointers ...string) JSONOption {
+	return func(opts *jsonOptions) {
+		for _, pointer := range pointers {
+			opts.ignored = append(opts.ignored, parseJSONPointer(pointer))
+		}
+	}
+}
+
+// JSONArraysAsSets compares the arrays at the JSON pointers as sets, so their order and duplicates are ignored.
+// A "*" segment matches every key of an object or every element of an array, and "" is the root value.
+//
+// Example:
+//
+//	assert.JSONEqual(t, `{"tags": ["a", "b"]}`, `{"tags": ["b", "a"]}`, assert.JSONArraysAsSets("/tags"))
+func JSONArraysAsSets(pointers ...string) JSONOption {
+	return func(opts *jsonOptions) {
+		for _, pointer := range pointers {
+			opts.sets = append(opts.sets, parseJSONPointer(pointer))
+		}
+	}
+}
+
+// JSONEqual asserts that two JSON strings are semantically equal. The formatting and the order of object keys are ignored.
+// Invalid JSON on either side is reported with the line and column of the syntax error.
+// JSONOptions can be passed before the custom message.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.JSONEqual(t, `{"name": "John", "age": 42}`, `{"age":42,"name":"John"}`)
+//	assert.JSONEqual(t, expected, actual, assert.IgnoreJSONPaths("/meta/requestId"))
+func JSONEqual(t testRunner, expected string, actual string, opts ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	compareJSON(t, expected, actual, false, opts)
+}
+
+// JSONContains asserts that the actual JSON contains the expected JSON.
+// Objects in actual may have additional keys, while arrays have to have the same length and are compared element by element.
+// JSONOptions can be passed before the custom message.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.JSONContains(t, `{"user": {"name": "John"}}`, `{"user": {"id": 7, "name": "John"}, "status": "ok"}`)
+func JSONContains(t testRunner, expectedSubset string, actual string, opts ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	compareJSON(t, expectedSubset, actual, true, opts)
+}
+
+// JSONEqualValue asserts that the actual JSON is equal to the JSON encoding of a Go value.
+// JSONOptions can be passed before the custom message.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.JSONEqualValue(t, map[string]any{"status": "ok"}, body)
+func JSONEqualValue(t testRunner, expected any, actualJSON string, opts ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	encoded, err := json.Marshal(expected)
+	if err != nil {
+		_, msg := splitJSONOptions(opts)
+		internal.Fail(t, "The expected value !!could not be encoded!! as JSON.", internal.NewObjectsSingleNamed("Error", err), msg...)
+		return
+	}
+
+	compareJSON(t, string(encoded), actualJSON, false, opts)
+}
+
+func splitJSONOptions(opts []any) (jsonOptions, []any) {
+	var options jsonOptions
+	for i, opt := range opts {
+		if o, ok := opt.(JSONOption); ok {
+			o(&options)
+			continue
+		}
+		return options, opts[i:]
+	}
+
+	return options, nil
+}
+
+func compareJSON(t testRunner, expected, actual string, subset bool, opts []any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	options, msg := splitJSONOptions(opts)
+
+	expectedValue, err := decodeJSON(expected)
+	if err != nil {
+		internal.Fail(t, "The expected JSON !!is invalid!!.", internal.Objects{parseErrorObject(expected, err)}, msg...)
+		return
+	}
+	actualValue, err := decodeJSON(actual)
+	if err != nil {
+		internal.Fail(t, "The actual JSON !!is invalid!!.", internal.Objects{parseErrorObject(actual, err)}, msg...)
+		return
+	}
+
+	for _, path := range options.ignored {
+		expectedValue = removeJSONPath(expectedValue, path)
+		actualValue = removeJSONPath(actualValue, path)
+	}
+	for _, path := range options.sets {
+		expectedValue = mapJSONPath(expectedValue, path, jsonSet)
+		actualValue = mapJSONPath(actualValue, path, jsonSet)
+	}
+	if subset {
+		actualValue = pruneJSON(actualValue, expectedValue)
+	}
+
+	a, _ := jd.ReadJsonString(encodeJSON(expectedValue))
+	b, _ := jd.ReadJsonString(encodeJSON(actualValue))
+	if d := a.Diff(b); len(d) > 0 {
+		message := "The JSON objects !!are not equal!!."
+		if subset {
+			message = "The actual JSON !!does not contain!! the expected JSON."
+		}
+		internal.Fail(t, message, internal.Objects{
+			{
+				Name:      "Difference",
+				NameStyle: pterm.NewStyle(pterm.FgYellow),
+				Data:      d.Render(),
+				Raw:       true,
+			},
+		}, msg...)
+	}
+}
+
+// jsonSyntaxError is a JSON syntax error with the position at which it was found.
+type jsonSyntaxError struct {
+	line, column int
+	err          error
+}
+
+func (e *jsonSyntaxError) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s", e.line, e.column, e.err)
+}
+
+func decodeJSON(s string) (any, error) {
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.UseNumber()
+
+	var value any
+	err := decoder.Decode(&value)
+	if err == nil {
+		rest := s[decoder.InputOffset():]
+		if trailing := strings.TrimLeft(rest, " \t\r\n"); trailing != "" {
+			offset := int64(len(s) - len(trailing))
+			return nil, newJSONSyntaxError(s, offset, errors.New("unexpected data after the JSON value"))
+		}
+		return value, nil
+	}
+
+	var syntaxErr *json.SyntaxError
+	switch {
+	case errors.As(err, &syntaxErr):
+		return nil, newJSONSyntaxError(s, syntaxErr.Offset-1, err)
+	case errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF):
+		return nil, newJSONSyntaxError(s, int64(len(s)), errors.New("unexpected end of JSON input"))
+	}
+
+	return nil, err
+}
+
+func newJSONSyntaxError(s string, offset int64, err error) *jsonSyntaxError {
+	offset = max(0, min(offset, int64(len(s))))
+	before := s[:offset]
+	line := strings.Count(before, "\n") + 1
+	column := len(before) - strings.LastIndex(before, "\n")
+
+	return &jsonSyntaxError{line: line, column: column, err: err}
+}
+
+// parseErrorObject shows a parse error. JSON syntax errors also show the line of the input they were found in.
+func parseErrorObject(input string, err error) internal.Object {
+	data := err.Error() + "\n"
+
+	var syntaxErr *jsonSyntaxError
+	if errors.As(err, &syntaxErr) {
+		lines := strings.Split(input, "\n")
+		if syntaxErr.line <= len(lines) {
+			line := strings.ReplaceAll(lines[syntaxErr.line-1], "\t", " ")
+			data += "\n" + line + "\n" + strings.Repeat(" ", max(0, syntaxErr.column-1)) + "^\n"
+		}
+	}
+
+	return internal.Object{
+		Name:      "Parse Error",
+		NameStyle: pterm.NewStyle(pterm.FgLightRed),
+		Data:      data,
+		Raw:       true,
+	}
+}
+
+func encodeJSON(value any) string {
+	encoded, _ := json.Marshal(value)
+	return string(encoded)
+}
+
+// parseJSONPointer splits a JSON pointer (RFC 6901) into its unescaped segments.
+func parseJSONPointer(pointer string) []string {
+	if pointer == "" {
+		return nil
+	}
+
+	segments := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+	}
+
+	return segments
+}
+
+// removeJSONPath removes the values at the path from a decoded JSON value.
+func removeJSONPath(value any, path []string) any {
+	if len(path) == 0 {
+		return nil
+	}
+
+	segment, rest := path[0], path[1:]
+	switch v := value.(type) {
+	case map[string]any:
+		for key := range v {
+			if segment == "*" || segment == key {
+				if len(rest) == 0 {
+					delete(v, key)
+				} else {
+					v[key] = removeJSONPath(v[key], rest)
+				}
+			}
+		}
+	case []any:
+		kept := make([]any, 0, len(v))
+		for i, element := range v {
+			if segment == "*" || segment == strconv.Itoa(i) {
+				if len(rest) == 0 {
+					continue
+				}
+				element = removeJSONPath(element, rest)
+			}
+			kept = append(kept, element)
+		}
+		return kept
+	}
+
+	return value
+}
+
+// mapJSONPath replaces the values at the path of a decoded JSON value with the result of f.
+func mapJSONPath(value any, path []string, f func(any) any) any {
+	if len(path) == 0 {
+		return f(value)
+	}
+
+	segment, rest := path[0], path[1:]
+	switch v := value.(type) {
+	case map[string]any:
+		for key := range v {
+			if segment == "*" || segment == key {
+				v[key] = mapJSONPath(v[key], rest, f)
+			}
+		}
+	case []any:
+		for i := range v {
+			if segment == "*" || segment == strconv.Itoa(i) {
+				v[i] = mapJSONPath(v[i], rest, f)
+			}
+		}
+	}
+
+	return value
+}
+
+// jsonSet sorts the elements of an array by their encoding and removes duplicates.
+func jsonSet(value any) any {
+	array, ok := value.([]any)
+	if !ok {
+		return value
+	}
+
+	encoded := make(map[string]any, len(array))
+	for _, element := range array {
+		encoded[encodeJSON(element)] = element
+	}
+
+	keys := make([]string, 0, len(encoded))
+	for key := range encoded {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	set := make([]any, 0, len(keys))
+	for _, key := range keys {
+		set = append(set, encoded[key])
+	}
+
+	return set
+}
+
+// pruneJSON removes every object key from actual that is not in expected, so that only the expected subset is compared.
+func pruneJSON(actual, expected any) any {
+	switch e := expected.(type) {
+	case map[string]any:
+		a, ok := actual.(map[string]any)
+		if !ok {
+			return actual
+		}
+		pruned := make(map[string]any, len(e))
+		for key, value := range a {
+			if expectedValue, ok := e[key]; ok {
+				pruned[key] = pruneJSON(value, expectedValue)
+			}
+		}
+		return pruned
+	case []any:
+		a, ok := actual.([]any)
+		if !ok || len(a) != len(e) {
+			return actual
+		}
+		pruned := make([]any, len(a))
+		for i := range a {
+			pruned[i] = pruneJSON(a[i], e[i])
+		}
+		return pruned
+	}
+
+	return actual
+}
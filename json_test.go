@@ -0,0 +1,104 @@
+package assert_test
+
+import (
+	"testing"
+
+	. "github.com/chalk-ai/assert"
+)
+
+func TestJSONEqual_parse_errors(t *testing.T) {
+	var tm testMock
+	JSONEqual(&tm, `{"foo": "bar"}`, "{\n  \"foo\": \"bar\",\n  \"baz\" 1\n}")
+
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "actual JSON")
+	Contains(t, tm.ErrorMessage, "line 3, column 9")
+
+	tm = testMock{}
+	JSONEqual(&tm, `{"foo": `, `{"foo": "bar"}`)
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "expected JSON")
+	Contains(t, tm.ErrorMessage, "unexpected end of JSON input")
+
+	tm = testMock{}
+	JSONEqual(&tm, `{}`, `{} {}`)
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "line 1, column 4")
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		JSONEqual(t, ``, `{}`)
+	})
+}
+
+func TestJSONEqual_ignore_paths(t *testing.T) {
+	JSONEqual(t,
+		`{"meta": {"requestId": "a"}, "items": [{"id": 1, "createdAt": "x"}]}`,
+		`{"meta": {"requestId": "b"}, "items": [{"id": 1, "createdAt": "y"}]}`,
+		IgnoreJSONPaths("/meta/requestId", "/items/*/createdAt"))
+
+	JSONEqual(t, `{"a/b": 1, "c": 2}`, `{"a/b": 3, "c": 2}`, IgnoreJSONPaths("/a~1b"))
+}
+
+func TestJSONEqual_ignore_paths_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		JSONEqual(t, `{"id": 1, "name": "a"}`, `{"id": 2, "name": "b"}`, IgnoreJSONPaths("/id"), "custom %s", "message")
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		JSONEqual(t, `{"a": []}`, `{"a": null}`, IgnoreJSONPaths("/a/*/id"))
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		JSONEqual(t, `{"a": [1]}`, `{"a": null}`, IgnoreJSONPaths("/a/*"))
+	})
+}
+
+func TestJSONEqual_sets(t *testing.T) {
+	JSONEqual(t, `{"tags": ["a", "b"]}`, `{"tags": ["b", "a", "a"]}`, JSONArraysAsSets("/tags"))
+	JSONEqual(t, `[[1, 2], [3]]`, `[[2, 1], [3]]`, JSONArraysAsSets("/*"))
+}
+
+func TestJSONEqual_sets_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		JSONEqual(t, `{"tags": ["a", "b"], "order": [1, 2]}`, `{"tags": ["b", "a"], "order": [2, 1]}`, JSONArraysAsSets("/tags"))
+	})
+}
+
+func TestJSONContains(t *testing.T) {
+	JSONContains(t, `{"user": {"name": "John"}}`, `{"user": {"id": 7, "name": "John"}, "status": "ok"}`)
+	JSONContains(t, `[{"id": 1}, {"id": 2}]`, `[{"id": 1, "x": true}, {"id": 2}]`)
+}
+
+func TestJSONContains_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		JSONContains(t, `{"user": {"name": "Jane"}}`, `{"user": {"id": 7, "name": "John"}}`)
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		JSONContains(t, `{"missing": true}`, `{"other": true}`)
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		JSONContains(t, `[1, 2]`, `[1, 2, 3]`)
+	})
+}
+
+func TestJSONEqualValue(t *testing.T) {
+	type user struct {
+		Name string `json:"name"`
+		Age  int    `json:"age"`
+	}
+
+	JSONEqualValue(t, user{Name: "John", Age: 42}, `{"age": 42, "name": "John"}`)
+	JSONEqualValue(t, []int{1, 2}, `[1, 2]`)
+}
+
+func TestJSONEqualValue_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		JSONEqualValue(t, map[string]int{"a": 1}, `{"a": 2}`)
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		JSONEqualValue(t, make(chan int), `{}`)
+	})
+}
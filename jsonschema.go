@@ -0,0 +1,181 @@
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+
+	"github.com/chalk-ai/assert/internal"
+)
+
+// JSONSchemaOption customizes the schema resolution of MatchesJSONSchema.
+type JSONSchemaOption func(opts *jsonSchemaOptions)
+
+type jsonSchemaOptions struct {
+	refs fs.FS
+}
+
+// JSONSchemaRefsFromDir resolves the relative $refs of a schema from files in a directory.
+//
+// Example:
+//
+//	assert.MatchesJSONSchema(t, schema, body, assert.JSONSchemaRefsFromDir("testdata/schemas"))
+func JSONSchemaRefsFromDir(dir string) JSONSchemaOption {
+	return JSONSchemaRefsFromFS(os.DirFS(dir))
+}
+
+// JSONSchemaRefsFromFS resolves the relative $refs of a schema from files in a file system, like an embed.FS.
+//
+// Example:
+//
+//	//go:embed schemas
+//	var schemas embed.FS
+//
+//	sub, _ := fs.Sub(schemas, "schemas")
+//	assert.MatchesJSONSchema(t, schema, body, assert.JSONSchemaRefsFromFS(sub))
+func JSONSchemaRefsFromFS(fsys fs.FS) JSONSchemaOption {
+	return func(opts *jsonSchemaOptions) {
+		opts.refs = fsys
+	}
+}
+
+// jsonSchemaScheme is the URL scheme of local schemas. Schemas with other URLs are never loaded, so no network is used.
+const jsonSchemaScheme = "schema"
+
+const jsonSchemaRoot = jsonSchemaScheme + ":///schema.json"
+
+// MatchesJSONSchema asserts that a JSON document is valid against a JSON Schema.
+// Schemas without a "$schema" keyword use draft 2020-12.
+// Relative $refs are resolved from the files of JSONSchemaRefsFromDir or JSONSchemaRefsFromFS, remote schemas are never loaded.
+// Every violation is reported with the JSON pointer of the invalid value and the failing keyword.
+// JSONSchemaOptions can be passed before the custom message.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.MatchesJSONSchema(t, `{
+//		"type": "object",
+//		"required": ["name"],
+//		"properties": {"name": {"type": "string"}}
+//	}`, `{"name": "John"}`)
+func MatchesJSONSchema(t testRunner, schema string, document string, opts ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	var options jsonSchemaOptions
+	var msg []any
+	for i, opt := range opts {
+		if o, ok := opt.(JSONSchemaOption); ok {
+			o(&options)
+			continue
+		}
+		msg = opts[i:]
+		break
+	}
+
+	schemaValue, err := decodeJSON(schema)
+	if err != nil {
+		internal.Fail(t, "The JSON schema !!is invalid!!.", internal.Objects{parseErrorObject(schema, err)}, msg...)
+		return
+	}
+	documentValue, err := decodeJSON(document)
+	if err != nil {
+		internal.Fail(t, "The JSON document !!is invalid!!.", internal.Objects{parseErrorObject(document, err)}, msg...)
+		return
+	}
+
+	compiler := jsonschema.NewCompiler()
+	compiler.DefaultDraft(jsonschema.Draft2020)
+	compiler.UseLoader(jsonschema.SchemeURLLoader{jsonSchemaScheme: fsSchemaLoader{fsys: options.refs}})
+	if err := compiler.AddResource(jsonSchemaRoot, schemaValue); err != nil {
+		internal.Fail(t, "The JSON schema !!could not be compiled!!.", internal.NewObjectsSingleNamed("Error", err.Error()), msg...)
+		return
+	}
+	compiled, err := compiler.Compile(jsonSchemaRoot)
+	if err != nil {
+		internal.Fail(t, "The JSON schema !!could not be compiled!!.", internal.NewObjectsSingleNamed("Error", err.Error()), msg...)
+		return
+	}
+
+	err = compiled.Validate(documentValue)
+	var validationErr *jsonschema.ValidationError
+	if errors.As(err, &validationErr) {
+		rows := jsonSchemaViolations(validationErr, nil)
+		internal.Fail(t, fmt.Sprintf("The JSON document !!does not match the schema!!, %d violation(s) were found.", len(rows)), internal.Objects{
+			internal.NewTableObject("Violations", []string{"Instance", "Keyword", "Schema Location", "Error"}, rows),
+		}, msg...)
+	} else if err != nil {
+		internal.Fail(t, "The JSON document !!could not be validated!!.", internal.NewObjectsSingleNamed("Error", err.Error()), msg...)
+	}
+}
+
+var jsonSchemaPrinter = message.NewPrinter(language.English)
+
+// jsonSchemaViolations returns a row for every leaf of the validation error tree, which is a single failing keyword.
+func jsonSchemaViolations(err *jsonschema.ValidationError, rows [][]string) [][]string {
+	if len(err.Causes) > 0 {
+		for _, cause := range err.Causes {
+			rows = jsonSchemaViolations(cause, rows)
+		}
+		return rows
+	}
+
+	instance := "(root)"
+	if len(err.InstanceLocation) > 0 {
+		instance = jsonPointer(err.InstanceLocation)
+	}
+
+	keywordPath := err.ErrorKind.KeywordPath()
+	keyword := ""
+	if len(keywordPath) > 0 {
+		keyword = keywordPath[len(keywordPath)-1]
+	}
+
+	location := strings.TrimPrefix(err.SchemaURL, jsonSchemaScheme+":///")
+	if len(keywordPath) > 0 {
+		location = strings.TrimSuffix(location, "/") + jsonPointer(keywordPath)
+	}
+
+	return append(rows, []string{instance, keyword, location, err.ErrorKind.LocalizedString(jsonSchemaPrinter)})
+}
+
+func jsonPointer(tokens []string) string {
+	var pointer strings.Builder
+	for _, token := range tokens {
+		pointer.WriteString("/" + strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1"))
+	}
+
+	return pointer.String()
+}
+
+// fsSchemaLoader loads schemas with local URLs from a file system.
+type fsSchemaLoader struct {
+	fsys fs.FS
+}
+
+func (l fsSchemaLoader) Load(rawURL string) (any, error) {
+	if l.fsys == nil {
+		return nil, fmt.Errorf("cannot load %s: no directory or file system for $refs is configured", rawURL)
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := fs.ReadFile(l.fsys, strings.TrimPrefix(u.Path, "/"))
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeJSON(string(content))
+}
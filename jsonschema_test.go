@@ -0,0 +1,95 @@
+package assert_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	. "github.com/chalk-ai/assert"
+)
+
+const userSchema = `{
+	"type": "object",
+	"required": ["name", "age"],
+	"properties": {
+		"name": {"type": "string"},
+		"age": {"type": "integer", "minimum": 0},
+		"tags": {"type": "array", "items": {"type": "string"}, "uniqueItems": true}
+	},
+	"additionalProperties": false
+}`
+
+func TestMatchesJSONSchema(t *testing.T) {
+	MatchesJSONSchema(t, `{"type": "string"}`, `"hello"`)
+	MatchesJSONSchema(t, userSchema, `{"name": "John", "age": 42, "tags": ["a", "b"]}`)
+}
+
+func TestMatchesJSONSchema_fails(t *testing.T) {
+	var tm testMock
+	MatchesJSONSchema(&tm, userSchema, `{"name": 7, "age": -1, "tags": ["a", "a"], "extra": true}`)
+
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "4 violation(s)")
+	Contains(t, tm.ErrorMessage, "/name")
+	Contains(t, tm.ErrorMessage, "minimum")
+	Contains(t, tm.ErrorMessage, "uniqueItems")
+	Contains(t, tm.ErrorMessage, "additionalProperties")
+
+	tm = testMock{}
+	MatchesJSONSchema(&tm, userSchema, `{}`)
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "required")
+}
+
+const customerSchema = `{
+	"type": "object",
+	"properties": {
+		"address": {"$ref": "address.json"}
+	}
+}`
+
+func TestMatchesJSONSchema_refs_from_dir(t *testing.T) {
+	MatchesJSONSchema(t, customerSchema, `{"name": "John", "age": 42, "address": {"city": "Berlin", "zip": "10115"}}`,
+		JSONSchemaRefsFromDir("testdata/schemas"))
+
+	var tm testMock
+	MatchesJSONSchema(&tm, customerSchema, `{"name": "John", "age": 42, "address": {"city": "", "zip": "1"}}`,
+		JSONSchemaRefsFromDir("testdata/schemas"), "custom message")
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "/address/zip")
+	Contains(t, tm.ErrorMessage, "address.json#/properties/zip/pattern")
+	Contains(t, tm.ErrorMessage, "custom message")
+}
+
+func TestMatchesJSONSchema_refs_from_fs(t *testing.T) {
+	refs := fstest.MapFS{
+		"defs/id.json": {Data: []byte(`{"type": "string", "format": "uuid", "minLength": 36}`)},
+	}
+
+	MatchesJSONSchema(t, `{"$ref": "defs/id.json"}`, `"123e4567-e89b-12d3-a456-426614174000"`, JSONSchemaRefsFromFS(refs))
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		MatchesJSONSchema(t, `{"$ref": "defs/id.json"}`, `"123"`, JSONSchemaRefsFromFS(refs))
+	})
+}
+
+func TestMatchesJSONSchema_invalid_input(t *testing.T) {
+	var tm testMock
+	MatchesJSONSchema(&tm, `{"type": "object"`, `{}`)
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "unexpected end of JSON input")
+
+	tm = testMock{}
+	MatchesJSONSchema(&tm, `{"type": "object"}`, `{"a": }`)
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "line 1, column 7")
+
+	tm = testMock{}
+	MatchesJSONSchema(&tm, `{"$ref": "missing.json"}`, `{}`)
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "could not be compiled")
+
+	tm = testMock{}
+	MatchesJSONSchema(&tm, `{"$ref": "https://example.com/schema.json"}`, `{}`)
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "could not be compiled")
+}
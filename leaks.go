@@ -0,0 +1,243 @@
+package assert
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pterm/pterm"
+
+	"github.com/chalk-ai/assert/internal"
+)
+
+// LeakOption customizes the goroutine leak detection of NoGoroutineLeaks and VerifyNoLeaks.
+type LeakOption func(opts *leakOptions)
+
+type leakOptions struct {
+	ignore      []*regexp.Regexp
+	gracePeriod time.Duration
+}
+
+// IgnoreGoroutines ignores goroutines whose stack trace matches one of the regular expressions.
+// Use it for goroutines that are expected to outlive a test, like background workers of a library.
+// Invalid patterns panic.
+//
+// Example:
+//
+//	assert.NoGoroutineLeaks(t, assert.IgnoreGoroutines(`go\.opencensus\.io/stats/view\.\(\*worker\)\.start`))
+func IgnoreGoroutines(patterns ...string) LeakOption {
+	return func(opts *leakOptions) {
+		for _, pattern := range patterns {
+			opts.ignore = append(opts.ignore, regexp.MustCompile(pattern))
+		}
+	}
+}
+
+// LeakGracePeriod sets how long the leak detection waits for goroutines to finish, before they are reported.
+// The default is one second.
+//
+// Example:
+//
+//	assert.NoGoroutineLeaks(t, assert.LeakGracePeriod(5*time.Second))
+func LeakGracePeriod(d time.Duration) LeakOption {
+	return func(opts *leakOptions) {
+		opts.gracePeriod = d
+	}
+}
+
+// defaultIgnoredFunctions contains functions of goroutines that belong to the runtime or the testing package.
+var defaultIgnoredFunctions = []string{
+	"testing.tRunner",
+	"testing.runTests",
+	"testing.runFuzzTests",
+	"testing.runFuzzing",
+	"testing.(*M).",
+	"testing.(*T).Run",
+	"testing.(*F).Fuzz",
+	"os/signal.signal_recv",
+	"os/signal.loop",
+	"runtime.ensureSigM",
+	"runtime.ReadTrace",
+}
+
+type cleaner interface {
+	Cleanup(f func())
+}
+
+// NoGoroutineLeaks asserts that a test does not leak goroutines.
+// It takes a snapshot of the running goroutines and registers a check with t.Cleanup,
+// which reports every goroutine that was started during the test and is still running after a grace period.
+// Goroutines of the runtime and the testing package are ignored.
+//
+// NOTE: Tests that use NoGoroutineLeaks should not run in parallel, as goroutines of other tests would be reported.
+//
+// Example:
+//
+//	func TestServer(t *testing.T) {
+//		assert.NoGoroutineLeaks(t)
+//		// ...
+//	}
+func NoGoroutineLeaks(t testRunner, opts ...LeakOption) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	test, ok := t.(cleaner)
+	if !ok {
+		internal.Fail(t, "The test !!does not support Cleanup!!, which is needed to check for goroutine leaks.", internal.Objects{})
+		return
+	}
+
+	options := newLeakOptions(opts)
+	before := goroutineIDs(runningGoroutines())
+	test.Cleanup(func() {
+		if test, ok := t.(helper); ok {
+			test.Helper()
+		}
+
+		if leaked := findLeakedGoroutines(before, options); len(leaked) > 0 {
+			internal.Fail(t, fmt.Sprintf("The test !!leaked %d goroutine(s)!!.", len(leaked)), leakedGoroutineObjects(leaked))
+		}
+	})
+}
+
+// VerifyNoLeaks runs the tests of a package and fails, if goroutines are still running after all tests have finished.
+// Use it in TestMain. It calls os.Exit with the result of the tests.
+//
+// Example:
+//
+//	func TestMain(m *testing.M) {
+//		assert.VerifyNoLeaks(m)
+//	}
+func VerifyNoLeaks(m *testing.M, opts ...LeakOption) {
+	options := newLeakOptions(opts)
+	before := goroutineIDs(runningGoroutines())
+
+	code := m.Run()
+	if code == 0 {
+		if leaked := findLeakedGoroutines(before, options); len(leaked) > 0 {
+			fmt.Fprint(os.Stderr, internal.FailS(fmt.Sprintf("The tests !!leaked %d goroutine(s)!!.", len(leaked)), leakedGoroutineObjects(leaked)))
+			code = 1
+		}
+	}
+
+	os.Exit(code)
+}
+
+type goroutine struct {
+	ID    int
+	State string
+	Stack string
+}
+
+var goroutineHeader = regexp.MustCompile(`^goroutine (\d+) \[([^\]]*)\]:`)
+
+func newLeakOptions(opts []LeakOption) leakOptions {
+	options := leakOptions{gracePeriod: time.Second}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return options
+}
+
+// runningGoroutines parses the stack traces of every goroutine, except the calling one.
+func runningGoroutines() []goroutine {
+	buf := make([]byte, 64<<10)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			buf = buf[:n]
+			break
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+
+	var goroutines []goroutine
+	for i, block := range strings.Split(strings.TrimSpace(string(buf)), "\n\n") {
+		// The stack of the calling goroutine is always the first one.
+		if i == 0 {
+			continue
+		}
+
+		match := goroutineHeader.FindStringSubmatch(block)
+		if match == nil {
+			continue
+		}
+		id, _ := strconv.Atoi(match[1])
+		goroutines = append(goroutines, goroutine{ID: id, State: match[2], Stack: block})
+	}
+
+	return goroutines
+}
+
+func goroutineIDs(goroutines []goroutine) map[int]bool {
+	ids := make(map[int]bool, len(goroutines))
+	for _, g := range goroutines {
+		ids[g.ID] = true
+	}
+
+	return ids
+}
+
+// findLeakedGoroutines returns the goroutines that were not running before, retrying until the grace period is over.
+func findLeakedGoroutines(before map[int]bool, opts leakOptions) []goroutine {
+	deadline := time.Now().Add(opts.gracePeriod)
+	wait := time.Millisecond
+
+	for {
+		var leaked []goroutine
+		for _, g := range runningGoroutines() {
+			if !before[g.ID] && !isIgnoredGoroutine(g, opts) {
+				leaked = append(leaked, g)
+			}
+		}
+
+		if len(leaked) == 0 || time.Now().After(deadline) {
+			return leaked
+		}
+
+		time.Sleep(wait)
+		wait = min(2*wait, 100*time.Millisecond)
+	}
+}
+
+func isIgnoredGoroutine(g goroutine, opts leakOptions) bool {
+	for _, line := range strings.Split(g.Stack, "\n")[1:] {
+		if strings.HasPrefix(line, "\t") || strings.HasPrefix(line, "created by ") {
+			continue
+		}
+		for _, function := range defaultIgnoredFunctions {
+			if strings.HasPrefix(line, function) {
+				return true
+			}
+		}
+	}
+
+	for _, pattern := range opts.ignore {
+		if pattern.MatchString(g.Stack) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func leakedGoroutineObjects(leaked []goroutine) internal.Objects {
+	objects := make(internal.Objects, 0, len(leaked))
+	for _, g := range leaked {
+		objects = append(objects, internal.Object{
+			Name:      fmt.Sprintf("Goroutine %d [%s]", g.ID, g.State),
+			NameStyle: pterm.NewStyle(pterm.FgLightRed),
+			Data:      strings.Join(strings.Split(g.Stack, "\n")[1:], "\n") + "\n",
+			Raw:       true,
+		})
+	}
+
+	return objects
+}
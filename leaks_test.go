@@ -0,0 +1,79 @@
+package assert_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/chalk-ai/assert"
+)
+
+type cleanupMock struct {
+	testMock
+	cleanups []func()
+}
+
+func (m *cleanupMock) Cleanup(f func()) {
+	m.cleanups = append(m.cleanups, f)
+}
+
+func (m *cleanupMock) runCleanups() {
+	for i := len(m.cleanups) - 1; i >= 0; i-- {
+		m.cleanups[i]()
+	}
+}
+
+func TestNoGoroutineLeaks(t *testing.T) {
+	NoGoroutineLeaks(t)
+
+	done := make(chan struct{})
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		close(done)
+	}()
+}
+
+func TestNoGoroutineLeaks_fails(t *testing.T) {
+	var tm cleanupMock
+	NoGoroutineLeaks(&tm, LeakGracePeriod(20*time.Millisecond))
+
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		<-stop
+	}()
+
+	tm.runCleanups()
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "leaked 1 goroutine(s)")
+	Contains(t, tm.ErrorMessage, "TestNoGoroutineLeaks_fails")
+}
+
+func TestNoGoroutineLeaks_ignore(t *testing.T) {
+	var tm cleanupMock
+	NoGoroutineLeaks(&tm, LeakGracePeriod(20*time.Millisecond), IgnoreGoroutines(`TestNoGoroutineLeaks_ignore\.func\d+`))
+
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		<-stop
+	}()
+
+	tm.runCleanups()
+	False(t, tm.ErrorCalled, tm.ErrorMessage)
+}
+
+func TestNoGoroutineLeaks_completes_in(t *testing.T) {
+	NoGoroutineLeaks(t)
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		CompletesIn(t, time.Microsecond, func() {
+			time.Sleep(20 * time.Millisecond)
+		})
+	})
+}
+
+func TestNoGoroutineLeaks_without_cleanup(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		NoGoroutineLeaks(t)
+	})
+}
@@ -0,0 +1,159 @@
+package assert
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/chalk-ai/assert/internal"
+)
+
+// HasKey asserts that a map contains a key.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.HasKey(t, map[string]int{"a": 1}, "a")
+func HasKey[K comparable, V any](t testRunner, m map[K]V, key K, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	if _, ok := m[key]; !ok {
+		internal.Fail(t, fmt.Sprintf("A map !!does not contain the key %s!!, but should.", internal.FormatValue(key)), internal.Objects{
+			internal.NewObjectsSingleNamed("Keys", sortedMapKeys(m))[0],
+		}, msg...)
+	}
+}
+
+// NotHasKey asserts that a map does not contain a key.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.NotHasKey(t, map[string]int{"a": 1}, "b")
+func NotHasKey[K comparable, V any](t testRunner, m map[K]V, key K, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	if value, ok := m[key]; ok {
+		internal.Fail(t, fmt.Sprintf("A map !!does contain the key %s!!, but should not.", internal.FormatValue(key)), internal.Objects{
+			internal.NewObjectsSingleNamed("Value", value)[0],
+		}, msg...)
+	}
+}
+
+// HasEntry asserts that a map contains a key with the expected value.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.HasEntry(t, map[string]int{"a": 1}, "a", 1)
+func HasEntry[K comparable, V any](t testRunner, m map[K]V, key K, value V, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	actual, ok := m[key]
+	if !ok {
+		internal.Fail(t, fmt.Sprintf("A map !!does not contain the key %s!!, but should.", internal.FormatValue(key)), internal.Objects{
+			internal.NewObjectsSingleNamed("Keys", sortedMapKeys(m))[0],
+		}, msg...)
+		return
+	}
+
+	if !internal.IsEqual(value, actual) {
+		internal.Fail(t, fmt.Sprintf("The value of the key %s !!is not equal!! to the expected value.", internal.FormatValue(key)),
+			internal.NewObjectsExpectedActualWithDiff(value, actual), msg...)
+	}
+}
+
+// MapSubset asserts that every entry of expected is contained in actual.
+// Additional entries of actual are ignored, which makes it useful for partial map matching.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.MapSubset(t, map[string]int{"a": 1}, map[string]int{"a": 1, "b": 2})
+func MapSubset[K comparable, V any](t testRunner, expected, actual map[K]V, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	var rows [][]string
+	for _, key := range sortedMapKeys(expected) {
+		actualValue, ok := actual[key]
+		if !ok {
+			rows = append(rows, mapDifferenceRow(key, "missing", expected[key], nil))
+		} else if !internal.IsEqual(expected[key], actualValue) {
+			rows = append(rows, mapDifferenceRow(key, "differs", expected[key], actualValue))
+		}
+	}
+
+	if len(rows) > 0 {
+		internal.Fail(t, "A map !!is not a subset!! of the other map, but should be.", internal.Objects{
+			internal.NewTableObject("Differences", mapDifferenceHeader, rows),
+		}, msg...)
+	}
+}
+
+// SameKeys asserts that two maps have the same set of keys. The values are not compared.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.SameKeys(t, map[string]int{"a": 1, "b": 2}, map[string]bool{"b": true, "a": false})
+func SameKeys[K comparable, V any, W any](t testRunner, expected map[K]V, actual map[K]W, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	var rows [][]string
+	for _, key := range sortedMapKeys(expected) {
+		if _, ok := actual[key]; !ok {
+			rows = append(rows, mapDifferenceRow(key, "missing", expected[key], nil))
+		}
+	}
+	for _, key := range sortedMapKeys(actual) {
+		if _, ok := expected[key]; !ok {
+			rows = append(rows, mapDifferenceRow(key, "extra", nil, actual[key]))
+		}
+	}
+
+	if len(rows) > 0 {
+		internal.Fail(t, "Two maps that !!should have the same keys!!, do not have the same keys.", internal.Objects{
+			internal.NewTableObject("Differences", mapDifferenceHeader, rows),
+		}, msg...)
+	}
+}
+
+var mapDifferenceHeader = []string{"Key", "Status", "Expected", "Actual"}
+
+func mapDifferenceRow(key any, status string, expected, actual any) []string {
+	row := []string{internal.FormatValue(key), status, "", ""}
+	if status != "extra" {
+		row[2] = internal.FormatValue(expected)
+	}
+	if status != "missing" {
+		row[3] = internal.FormatValue(actual)
+	}
+
+	return row
+}
+
+func sortedMapKeys[K comparable, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return internal.FormatValue(keys[i]) < internal.FormatValue(keys[j])
+	})
+
+	return keys
+}
@@ -0,0 +1,68 @@
+package assert_test
+
+import (
+	"testing"
+
+	. "github.com/chalk-ai/assert"
+)
+
+func TestHasKey(t *testing.T) {
+	HasKey(t, map[string]int{"a": 1, "b": 2}, "a")
+	HasKey(t, map[int]any{1: nil}, 1)
+}
+
+func TestHasKey_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		HasKey(t, map[string]int{"a": 1}, "b")
+	})
+}
+
+func TestNotHasKey(t *testing.T) {
+	NotHasKey(t, map[string]int{"a": 1}, "b")
+}
+
+func TestNotHasKey_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		NotHasKey(t, map[string]int{"a": 1}, "a")
+	})
+}
+
+func TestHasEntry(t *testing.T) {
+	HasEntry(t, map[string][]int{"a": {1, 2}}, "a", []int{1, 2})
+}
+
+func TestHasEntry_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		HasEntry(t, map[string]int{"a": 1}, "a", 2)
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		HasEntry(t, map[string]int{"a": 1}, "b", 1)
+	})
+}
+
+func TestMapSubset(t *testing.T) {
+	MapSubset(t, map[string]int{"a": 1}, map[string]int{"a": 1, "b": 2})
+	MapSubset(t, map[string]int{}, map[string]int{"a": 1})
+	MapSubset(t, nil, map[string]int{"a": 1})
+}
+
+func TestMapSubset_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		MapSubset(t, map[string]int{"a": 1, "c": 3}, map[string]int{"a": 1, "b": 2})
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		MapSubset(t, map[string]int{"a": 2}, map[string]int{"a": 1})
+	})
+}
+
+func TestSameKeys(t *testing.T) {
+	SameKeys(t, map[string]int{"a": 1, "b": 2}, map[string]bool{"b": true, "a": false})
+}
+
+func TestSameKeys_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		SameKeys(t, map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1, "c": 3})
+	})
+}
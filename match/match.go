@@ -0,0 +1,449 @@
+// Package match contains composable matchers.
+//
+// Matchers can be passed to assert.Matches, or embedded into the expected value of a comparison,
+// in which case they are evaluated against the actual value at the same position.
+package match
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/chalk-ai/assert/internal"
+)
+
+// Matcher checks if a value satisfies a condition.
+// Match returns whether the value matched, together with an explanation of the result.
+// The explanation is used in failure messages, and by combinators like Not, which fail when their matcher succeeds.
+type Matcher interface {
+	Match(actual any) (bool, string)
+}
+
+// Func adapts an ordinary function to a Matcher.
+//
+// Example:
+//
+//	even := match.Func(func(actual any) (bool, string) {
+//		return actual.(int)%2 == 0, fmt.Sprintf("%d is odd", actual)
+//	})
+type Func func(actual any) (bool, string)
+
+// Match calls f(actual).
+func (f Func) Match(actual any) (bool, string) {
+	return f(actual)
+}
+
+// of returns v if it is a Matcher, or a matcher that checks for equality with v otherwise.
+func of(v any) Matcher {
+	if m, ok := v.(Matcher); ok {
+		return m
+	}
+
+	return Equal(v)
+}
+
+// Equal matches values that are deeply equal to the expected value.
+// Matchers that are part of the expected value are evaluated against the actual value at the same path.
+//
+// Example:
+//
+//	match.Equal(map[string]any{"id": match.Anything(), "name": "John"})
+func Equal(expected any) Matcher {
+	return Func(func(actual any) (bool, string) {
+		diffs := internal.Compare(expected, actual, internal.CompareOptions{})
+		if len(diffs) == 0 {
+			return true, fmt.Sprintf("%s is equal to %s", internal.FormatValue(actual), internal.FormatValue(expected))
+		}
+
+		if len(diffs) == 1 && diffs[0].Path == "" {
+			if _, ok := diffs[0].Expected.(internal.Matcher); ok {
+				return false, diffs[0].Reason
+			}
+			return false, fmt.Sprintf("%s is not equal to %s", internal.FormatValue(actual), internal.FormatValue(expected))
+		}
+
+		var reason strings.Builder
+		reason.WriteString(fmt.Sprintf("%s differs at %d path(s):", internal.FormatValue(actual), len(diffs)))
+		for _, d := range diffs {
+			reason.WriteString("\n" + indent(describeDifference(d)))
+		}
+
+		return false, reason.String()
+	})
+}
+
+// Anything matches every value.
+// It is useful to skip values that are not known in advance, like generated IDs.
+func Anything() Matcher {
+	return Func(func(actual any) (bool, string) {
+		return true, "anything matches"
+	})
+}
+
+// Not matches values that do not match m.
+// If m is not a Matcher, it is compared with Equal.
+//
+// Example:
+//
+//	match.Not(match.MatchesRegexp("^error"))
+func Not(m any) Matcher {
+	return Func(func(actual any) (bool, string) {
+		ok, reason := of(m).Match(actual)
+		if ok {
+			return false, "matched, but should not:\n" + indent(reason)
+		}
+
+		return true, "did not match:\n" + indent(reason)
+	})
+}
+
+// AllOf matches values that match every given matcher.
+// Values that are not a Matcher are compared with Equal.
+//
+// Example:
+//
+//	match.AllOf(match.HasField("Name", "John"), match.HasField("Age", 34))
+func AllOf(matchers ...any) Matcher {
+	return Func(func(actual any) (bool, string) {
+		for i, m := range matchers {
+			if ok, reason := of(m).Match(actual); !ok {
+				return false, fmt.Sprintf("matcher %d of %d in AllOf failed:\n%s", i+1, len(matchers), indent(reason))
+			}
+		}
+
+		return true, fmt.Sprintf("all %d matchers matched", len(matchers))
+	})
+}
+
+// AnyOf matches values that match at least one of the given matchers.
+// Values that are not a Matcher are compared with Equal.
+//
+// Example:
+//
+//	match.AnyOf("pending", "running")
+func AnyOf(matchers ...any) Matcher {
+	return Func(func(actual any) (bool, string) {
+		var reasons strings.Builder
+		for i, m := range matchers {
+			ok, reason := of(m).Match(actual)
+			if ok {
+				return true, fmt.Sprintf("matcher %d of %d in AnyOf matched:\n%s", i+1, len(matchers), indent(reason))
+			}
+			reasons.WriteString(fmt.Sprintf("\n%s", indent(fmt.Sprintf("matcher %d: %s", i+1, reason))))
+		}
+
+		return false, fmt.Sprintf("none of the %d matchers in AnyOf matched:%s", len(matchers), reasons.String())
+	})
+}
+
+// HasField matches structs, or pointers to structs, which have a field that matches m.
+// Nested fields can be accessed with a dotted path, like "Meta.ID".
+// If m is not a Matcher, the field is compared with Equal.
+//
+// Example:
+//
+//	match.HasField("Name", "John")
+//	match.HasField("Meta.CreatedAt", match.WithinDuration(time.Now(), time.Minute))
+func HasField(name string, m any) Matcher {
+	return Func(func(actual any) (bool, string) {
+		v := reflect.ValueOf(actual)
+		for _, part := range strings.Split(name, ".") {
+			v = indirect(v)
+			if v.Kind() != reflect.Struct {
+				return false, fmt.Sprintf("field %q can not be accessed on a value of type %s", name, typeName(v))
+			}
+
+			field, ok := v.Type().FieldByName(part)
+			if !ok {
+				return false, fmt.Sprintf("%s has no field %q", v.Type(), part)
+			}
+			if !field.IsExported() {
+				return false, fmt.Sprintf("field %q of %s is unexported", part, v.Type())
+			}
+			v = v.FieldByIndex(field.Index)
+		}
+
+		ok, reason := of(m).Match(v.Interface())
+		if !ok {
+			return false, fmt.Sprintf("field %q did not match:\n%s", name, indent(reason))
+		}
+
+		return true, fmt.Sprintf("field %q matched:\n%s", name, indent(reason))
+	})
+}
+
+// HasKey matches maps that contain the key.
+//
+// Example:
+//
+//	match.HasKey("id")
+func HasKey(key any) Matcher {
+	return Func(func(actual any) (bool, string) {
+		_, ok, reason := lookup(actual, key)
+		if reason != "" {
+			return false, reason
+		}
+		if !ok {
+			return false, fmt.Sprintf("map does not contain the key %s", internal.FormatValue(key))
+		}
+
+		return true, fmt.Sprintf("map contains the key %s", internal.FormatValue(key))
+	})
+}
+
+// HasEntry matches maps that contain the key, with a value that matches m.
+// If m is not a Matcher, the value is compared with Equal.
+//
+// Example:
+//
+//	match.HasEntry("status", match.AnyOf("pending", "running"))
+func HasEntry(key any, m any) Matcher {
+	return Func(func(actual any) (bool, string) {
+		value, ok, reason := lookup(actual, key)
+		if reason != "" {
+			return false, reason
+		}
+		if !ok {
+			return false, fmt.Sprintf("map does not contain the key %s", internal.FormatValue(key))
+		}
+
+		ok, reason = of(m).Match(value)
+		if !ok {
+			return false, fmt.Sprintf("value of key %s did not match:\n%s", internal.FormatValue(key), indent(reason))
+		}
+
+		return true, fmt.Sprintf("value of key %s matched:\n%s", internal.FormatValue(key), indent(reason))
+	})
+}
+
+// ElementsAre matches slices and arrays whose elements match the given matchers in order.
+// Values that are not a Matcher are compared with Equal.
+//
+// Example:
+//
+//	match.ElementsAre(1, match.Anything(), 3)
+func ElementsAre(elements ...any) Matcher {
+	return Func(func(actual any) (bool, string) {
+		v, reason := sequence(actual)
+		if reason != "" {
+			return false, reason
+		}
+		if v.Len() != len(elements) {
+			return false, fmt.Sprintf("expected %d elements, but got %d", len(elements), v.Len())
+		}
+
+		for i, element := range elements {
+			if ok, reason := of(element).Match(v.Index(i).Interface()); !ok {
+				return false, fmt.Sprintf("element [%d] did not match:\n%s", i, indent(reason))
+			}
+		}
+
+		return true, fmt.Sprintf("all %d elements matched", len(elements))
+	})
+}
+
+// UnorderedElementsAre matches slices and arrays whose elements match the given matchers in any order.
+// Every element has to be matched by exactly one matcher.
+// Values that are not a Matcher are compared with Equal.
+//
+// Example:
+//
+//	match.UnorderedElementsAre("b", "a", match.MatchesRegexp("^c"))
+func UnorderedElementsAre(elements ...any) Matcher {
+	return Func(func(actual any) (bool, string) {
+		v, reason := sequence(actual)
+		if reason != "" {
+			return false, reason
+		}
+		if v.Len() != len(elements) {
+			return false, fmt.Sprintf("expected %d elements, but got %d", len(elements), v.Len())
+		}
+
+		matches := make([][]bool, v.Len())
+		for i := range matches {
+			matches[i] = make([]bool, len(elements))
+			for j, element := range elements {
+				matches[i][j], _ = of(element).Match(v.Index(i).Interface())
+			}
+		}
+
+		// Find a one-to-one assignment between elements and matchers with augmenting paths.
+		assigned := make([]int, len(elements))
+		for j := range assigned {
+			assigned[j] = -1
+		}
+		var augment func(i int, tried []bool) bool
+		augment = func(i int, tried []bool) bool {
+			for j := range elements {
+				if !matches[i][j] || tried[j] {
+					continue
+				}
+				tried[j] = true
+				if assigned[j] == -1 || augment(assigned[j], tried) {
+					assigned[j] = i
+					return true
+				}
+			}
+			return false
+		}
+
+		var unmatched []string
+		for i := range matches {
+			if !augment(i, make([]bool, len(elements))) {
+				unmatched = append(unmatched, fmt.Sprintf("[%d] %s", i, internal.FormatValue(v.Index(i).Interface())))
+			}
+		}
+		if len(unmatched) > 0 {
+			var unused []string
+			for j, i := range assigned {
+				if i == -1 {
+					unused = append(unused, fmt.Sprintf("[%d] %s", j, describeMatcher(elements[j])))
+				}
+			}
+			return false, fmt.Sprintf("no one-to-one assignment of elements to matchers exists\nelements without a matcher:\n%s\nmatchers without an element:\n%s",
+				indent(strings.Join(unmatched, "\n")), indent(strings.Join(unused, "\n")))
+		}
+
+		return true, fmt.Sprintf("all %d elements matched", len(elements))
+	})
+}
+
+// WithinDuration matches time.Time values that are at most delta away from expected.
+//
+// Example:
+//
+//	match.WithinDuration(time.Now(), time.Second)
+func WithinDuration(expected time.Time, delta time.Duration) Matcher {
+	return Func(func(actual any) (bool, string) {
+		var actualTime time.Time
+		switch a := actual.(type) {
+		case time.Time:
+			actualTime = a
+		case *time.Time:
+			if a == nil {
+				return false, "time is nil"
+			}
+			actualTime = *a
+		default:
+			return false, fmt.Sprintf("%s is not a time.Time", internal.FormatValue(actual))
+		}
+
+		difference := actualTime.Sub(expected)
+		if difference.Abs() > delta {
+			return false, fmt.Sprintf("%s is %s away from %s, which is more than %s", actualTime, difference.Abs(), expected, delta)
+		}
+
+		return true, fmt.Sprintf("%s is within %s of %s", actualTime, delta, expected)
+	})
+}
+
+// MatchesRegexp matches strings, byte slices and fmt.Stringers, that match the regular expression.
+// An invalid pattern never matches and its compile error is reported.
+//
+// Example:
+//
+//	match.MatchesRegexp(`^user-\d+$`)
+func MatchesRegexp(pattern string) Matcher {
+	re, err := regexp.Compile(pattern)
+
+	return Func(func(actual any) (bool, string) {
+		if err != nil {
+			return false, fmt.Sprintf("invalid pattern %q: %s", pattern, err)
+		}
+
+		var s string
+		switch a := actual.(type) {
+		case string:
+			s = a
+		case []byte:
+			s = string(a)
+		case fmt.Stringer:
+			s = a.String()
+		default:
+			return false, fmt.Sprintf("%s is not a string", internal.FormatValue(actual))
+		}
+
+		if !re.MatchString(s) {
+			return false, fmt.Sprintf("%q does not match the pattern %q", s, pattern)
+		}
+
+		return true, fmt.Sprintf("%q matches the pattern %q", s, pattern)
+	})
+}
+
+// describeMatcher returns the value itself for plain values, as matchers have no description of their own.
+func describeMatcher(m any) string {
+	if _, ok := m.(Matcher); ok {
+		return "matcher"
+	}
+
+	return internal.FormatValue(m)
+}
+
+func describeDifference(d internal.Difference) string {
+	path := internal.FormatPath(d.Path)
+	if _, ok := d.Expected.(internal.Matcher); ok {
+		return fmt.Sprintf("%s:\n%s", path, indent(d.Reason))
+	}
+
+	return fmt.Sprintf("%s: expected %s, got %s (%s)", path, internal.FormatValue(d.Expected), internal.FormatValue(d.Actual), d.Reason)
+}
+
+func lookup(actual, key any) (value any, found bool, reason string) {
+	m := indirect(reflect.ValueOf(actual))
+	if m.Kind() != reflect.Map {
+		return nil, false, fmt.Sprintf("a value of type %s is not a map", typeName(m))
+	}
+
+	k := reflect.ValueOf(key)
+	switch {
+	case !k.IsValid():
+		k = reflect.Zero(m.Type().Key())
+	case k.Type().AssignableTo(m.Type().Key()):
+	case k.Type().ConvertibleTo(m.Type().Key()):
+		k = k.Convert(m.Type().Key())
+	default:
+		return nil, false, fmt.Sprintf("key %s can not be used as a key of %s", internal.FormatValue(key), m.Type())
+	}
+
+	v := m.MapIndex(k)
+	if !v.IsValid() {
+		return nil, false, ""
+	}
+
+	return v.Interface(), true, ""
+}
+
+func sequence(actual any) (reflect.Value, string) {
+	v := reflect.ValueOf(actual)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return v, fmt.Sprintf("a value of type %s is neither a slice nor an array", typeName(v))
+	}
+
+	return v, ""
+}
+
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+
+	return v
+}
+
+func typeName(v reflect.Value) string {
+	if !v.IsValid() {
+		return "nil"
+	}
+
+	return v.Type().String()
+}
+
+func indent(s string) string {
+	return "  " + strings.ReplaceAll(s, "\n", "\n  ")
+}
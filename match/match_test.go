@@ -0,0 +1,171 @@
+package match_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/chalk-ai/assert"
+	"github.com/chalk-ai/assert/match"
+)
+
+type matchTestStruct struct {
+	Name string
+	Age  int
+	Meta *matchTestStructNested
+}
+
+type matchTestStructNested struct {
+	ID        int
+	CreatedAt time.Time
+}
+
+func TestEqual(t *testing.T) {
+	now := time.Now()
+	actual := map[string]any{"id": 1337, "name": "John", "tags": []any{"a", "b"}, "created": now}
+
+	ok, _ := match.Equal(map[string]any{
+		"id":      match.Anything(),
+		"name":    "John",
+		"tags":    []any{"a", match.MatchesRegexp("^b$")},
+		"created": match.WithinDuration(now, time.Second),
+	}).Match(actual)
+	assert.True(t, ok)
+}
+
+func TestEqual_fails(t *testing.T) {
+	ok, reason := match.Equal(map[string]any{"id": match.Anything(), "name": "Bob"}).Match(map[string]any{"id": 1, "name": "John"})
+	assert.False(t, ok)
+	assert.Contains(t, reason, `["name"]`)
+
+	ok, reason = match.Equal(map[string]any{"id": 1}).Match(map[string]any{"id": 1, "extra": true})
+	assert.False(t, ok)
+	assert.Contains(t, reason, "unexpected key")
+}
+
+func TestNot(t *testing.T) {
+	ok, _ := match.Not(1).Match(2)
+	assert.True(t, ok)
+
+	ok, reason := match.Not(match.MatchesRegexp("^a")).Match("abc")
+	assert.False(t, ok)
+	assert.Contains(t, reason, "matched, but should not")
+}
+
+func TestAllOf(t *testing.T) {
+	s := matchTestStruct{Name: "John", Age: 34, Meta: &matchTestStructNested{ID: 7}}
+
+	ok, _ := match.AllOf(match.HasField("Name", "John"), match.HasField("Meta.ID", 7)).Match(s)
+	assert.True(t, ok)
+
+	ok, reason := match.AllOf(match.HasField("Name", "John"), match.HasField("Age", 35)).Match(s)
+	assert.False(t, ok)
+	assert.Contains(t, reason, "matcher 2 of 2 in AllOf failed")
+	assert.Contains(t, reason, `field "Age" did not match`)
+}
+
+func TestAnyOf(t *testing.T) {
+	ok, _ := match.AnyOf("pending", "running").Match("running")
+	assert.True(t, ok)
+
+	ok, reason := match.AnyOf("pending", "running").Match("done")
+	assert.False(t, ok)
+	assert.Contains(t, reason, "none of the 2 matchers in AnyOf matched")
+}
+
+func TestHasField_fails(t *testing.T) {
+	tests := []struct {
+		name   string
+		actual any
+		field  string
+	}{
+		{name: "Missing", actual: matchTestStruct{}, field: "Missing"},
+		{name: "Nil pointer", actual: matchTestStruct{}, field: "Meta.ID"},
+		{name: "No struct", actual: 5, field: "Name"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ok, _ := match.HasField(test.field, match.Anything()).Match(test.actual)
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestHasKey(t *testing.T) {
+	m := map[string]int{"a": 1}
+
+	ok, _ := match.HasKey("a").Match(m)
+	assert.True(t, ok)
+	ok, _ = match.HasKey("b").Match(m)
+	assert.False(t, ok)
+	ok, _ = match.HasKey("a").Match([]int{1})
+	assert.False(t, ok)
+}
+
+func TestHasEntry(t *testing.T) {
+	m := map[int64]string{1: "a"}
+
+	ok, _ := match.HasEntry(1, "a").Match(m)
+	assert.True(t, ok)
+	ok, _ = match.HasEntry(1, "b").Match(m)
+	assert.False(t, ok)
+}
+
+func TestElementsAre(t *testing.T) {
+	ok, _ := match.ElementsAre(1, match.Anything(), 3).Match([]int{1, 2, 3})
+	assert.True(t, ok)
+
+	ok, reason := match.ElementsAre(1, 2, 4).Match([]int{1, 2, 3})
+	assert.False(t, ok)
+	assert.Contains(t, reason, "element [2]")
+
+	ok, _ = match.ElementsAre(1, 2).Match([]int{1, 2, 3})
+	assert.False(t, ok)
+}
+
+func TestUnorderedElementsAre(t *testing.T) {
+	ok, _ := match.UnorderedElementsAre("b", match.MatchesRegexp("^a"), match.Anything()).Match([]string{"abc", "x", "b"})
+	assert.True(t, ok)
+
+	// A greedy assignment would give "a" to the first matcher and fail.
+	ok, _ = match.UnorderedElementsAre(match.Anything(), "b").Match([2]string{"b", "a"})
+	assert.True(t, ok)
+
+	ok, reason := match.UnorderedElementsAre(1, 1, 2).Match([]int{1, 2, 2})
+	assert.False(t, ok)
+	assert.Contains(t, reason, "elements without a matcher")
+}
+
+func TestWithinDuration(t *testing.T) {
+	now := time.Now()
+
+	ok, _ := match.WithinDuration(now, time.Second).Match(now.Add(500 * time.Millisecond))
+	assert.True(t, ok)
+	ok, _ = match.WithinDuration(now, time.Second).Match(now.Add(-2 * time.Second))
+	assert.False(t, ok)
+	ok, _ = match.WithinDuration(now, time.Second).Match("now")
+	assert.False(t, ok)
+}
+
+func TestMatchesRegexp(t *testing.T) {
+	ok, _ := match.MatchesRegexp(`^user-\d+$`).Match("user-42")
+	assert.True(t, ok)
+	ok, _ = match.MatchesRegexp(`^user-\d+$`).Match([]byte("user-x"))
+	assert.False(t, ok)
+
+	ok, reason := match.MatchesRegexp(`(`).Match("(")
+	assert.False(t, ok)
+	assert.Contains(t, reason, "invalid pattern")
+}
+
+func TestFunc(t *testing.T) {
+	isErr := match.Func(func(actual any) (bool, string) {
+		_, ok := actual.(error)
+		return ok, "value is not an error"
+	})
+
+	ok, _ := isErr.Match(errors.New("test"))
+	assert.True(t, ok)
+	ok, _ = isErr.Match(1)
+	assert.False(t, ok)
+}
@@ -0,0 +1,243 @@
+package assert
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/chalk-ai/assert/internal"
+)
+
+// InDelta asserts that the absolute difference between two numbers is at most delta.
+// Two NaN values are considered equal, and an infinite value is only within delta of the same infinity.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.InDelta(t, 3.14, math.Pi, 0.01)
+func InDelta[T number](t testRunner, expected, actual T, delta float64, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	if reason := checkDelta(float64(expected), float64(actual), delta); reason != "" {
+		internal.Fail(t, reason, toleranceObjects(expected, actual, "Delta", delta), msg...)
+	}
+}
+
+// InEpsilon asserts that the relative error between two numbers is at most epsilon.
+// The relative error is the absolute difference divided by the absolute expected value.
+// Two NaN values are considered equal, and an infinite value is only within epsilon of the same infinity.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.InEpsilon(t, 100, 101, 0.02)
+func InEpsilon[T number](t testRunner, expected, actual T, epsilon float64, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	e, a := float64(expected), float64(actual)
+	if reason := checkSpecialValues(e, a, epsilon, "epsilon"); reason != "" {
+		internal.Fail(t, reason, internal.NewObjectsExpectedActual(expected, actual), msg...)
+		return
+	}
+	if e == a {
+		return
+	}
+
+	if e == 0 {
+		internal.Fail(t, "The relative error !!is undefined!!, because the expected value is zero. Use InDelta instead.",
+			internal.NewObjectsExpectedActual(expected, actual), msg...)
+		return
+	}
+
+	if relative := math.Abs(e-a) / math.Abs(e); relative > epsilon {
+		internal.Fail(t, "Two numbers !!are not within epsilon!! of each other, but should be.", append(internal.NewObjectsExpectedActual(expected, actual),
+			internal.NewObjectsSingleNamed("Relative Error", formatFloat(relative))[0],
+			internal.NewObjectsSingleNamed("Allowed Epsilon", formatFloat(epsilon))[0],
+		), msg...)
+	}
+}
+
+// WithinULPs asserts that two floating point numbers are at most maxULPs representable values apart.
+// Unlike a fixed delta, this tolerance scales with the magnitude of the numbers.
+// Positive and negative zero are equal, and two NaN values are considered equal.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.WithinULPs(t, 0.3, 0.1+0.2, 1)
+func WithinULPs[T float](t testRunner, expected, actual T, maxULPs uint64, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	e, a := float64(expected), float64(actual)
+	if reason := checkSpecialValues(e, a, 0, "ULPs"); reason != "" {
+		internal.Fail(t, reason, internal.NewObjectsExpectedActual(expected, actual), msg...)
+		return
+	}
+
+	if ulps := ulpDistance(expected, actual); ulps > maxULPs {
+		internal.Fail(t, "Two numbers !!are not within the allowed ULPs!! of each other, but should be.", append(internal.NewObjectsExpectedActual(expected, actual),
+			internal.NewObjectsSingleNamed("ULP Distance", ulps)[0],
+			internal.NewObjectsSingleNamed("Allowed ULPs", maxULPs)[0],
+		), msg...)
+	}
+}
+
+// InDeltaSlice asserts that two slices have the same length and that every pair of elements is within delta.
+// Every element that is not within delta is reported with its index.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.InDeltaSlice(t, []float64{1, 2, 3}, []float64{1.001, 1.999, 3}, 0.01)
+func InDeltaSlice[T number](t testRunner, expected, actual []T, delta float64, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	if len(expected) != len(actual) {
+		internal.Fail(t, fmt.Sprintf("Two slices !!have different lengths!! (%d and %d), but should be within delta.", len(expected), len(actual)),
+			internal.NewObjectsExpectedActual(expected, actual), msg...)
+		return
+	}
+
+	var rows [][]string
+	for i := range expected {
+		if checkDelta(float64(expected[i]), float64(actual[i]), delta) != "" {
+			rows = append(rows, deltaRow(fmt.Sprint(i), expected[i], actual[i]))
+		}
+	}
+
+	if len(rows) > 0 {
+		internal.Fail(t, fmt.Sprintf("%d element(s) !!are not within delta %s!!, but should be.", len(rows), formatFloat(delta)), internal.Objects{
+			internal.NewTableObject("Differences", []string{"Index", "Expected", "Actual", "Delta"}, rows),
+		}, msg...)
+	}
+}
+
+// InDeltaMapValues asserts that two maps have the same keys and that the values of every key are within delta.
+// Missing and extra keys, and every value that is not within delta, are reported.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.InDeltaMapValues(t, map[string]float64{"p99": 0.25}, latencies, 0.05)
+func InDeltaMapValues[K comparable, T number](t testRunner, expected, actual map[K]T, delta float64, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	var rows [][]string
+	for _, key := range sortedMapKeys(expected) {
+		actualValue, ok := actual[key]
+		if !ok {
+			rows = append(rows, []string{internal.FormatValue(key), internal.FormatValue(expected[key]), "missing", ""})
+		} else if checkDelta(float64(expected[key]), float64(actualValue), delta) != "" {
+			rows = append(rows, deltaRow(internal.FormatValue(key), expected[key], actualValue))
+		}
+	}
+	for _, key := range sortedMapKeys(actual) {
+		if _, ok := expected[key]; !ok {
+			rows = append(rows, []string{internal.FormatValue(key), "extra", internal.FormatValue(actual[key]), ""})
+		}
+	}
+
+	if len(rows) > 0 {
+		internal.Fail(t, fmt.Sprintf("The values of two maps !!are not within delta %s!!, but should be.", formatFloat(delta)), internal.Objects{
+			internal.NewTableObject("Differences", []string{"Key", "Expected", "Actual", "Delta"}, rows),
+		}, msg...)
+	}
+}
+
+// checkDelta returns the reason why two numbers are not within delta, or an empty string if they are.
+func checkDelta(expected, actual, delta float64) string {
+	if reason := checkSpecialValues(expected, actual, delta, "delta"); reason != "" {
+		return reason
+	}
+
+	if math.Abs(expected-actual) > delta {
+		return "Two numbers !!are not within delta!! of each other, but should be."
+	}
+
+	return ""
+}
+
+// checkSpecialValues handles invalid tolerances, NaN and infinite values, which cannot be compared by their difference.
+func checkSpecialValues(expected, actual, tolerance float64, toleranceName string) string {
+	switch {
+	case math.IsNaN(tolerance) || tolerance < 0:
+		return fmt.Sprintf("The allowed %s !!must be a non-negative number!!, but is %s.", toleranceName, formatFloat(tolerance))
+	case math.IsNaN(expected) && math.IsNaN(actual):
+		return ""
+	case math.IsNaN(expected) || math.IsNaN(actual):
+		return "One of the numbers !!is NaN!!, but both or none should be."
+	case math.IsInf(expected, 0) || math.IsInf(actual, 0):
+		if expected != actual {
+			return "An infinite number !!is only equal to the same infinity!!."
+		}
+	}
+
+	return ""
+}
+
+// ulpDistance returns the number of representable floating point values between a and b.
+func ulpDistance[T float](a, b T) uint64 {
+	if a == b {
+		return 0
+	}
+
+	var x, y uint64
+	switch a := any(a).(type) {
+	case float32:
+		x, y = orderedBits(uint64(math.Float32bits(a)), 32), orderedBits(uint64(math.Float32bits(float32(b))), 32)
+	case float64:
+		x, y = orderedBits(math.Float64bits(a), 64), orderedBits(math.Float64bits(float64(b)), 64)
+	}
+
+	if x > y {
+		return x - y
+	}
+
+	return y - x
+}
+
+// orderedBits maps the bits of a float to an integer, which is ordered like the float values.
+// Positive and negative zero are mapped to the same integer.
+func orderedBits(bits uint64, size int) uint64 {
+	sign := uint64(1) << (size - 1)
+	if bits&sign != 0 {
+		return sign - (bits &^ sign)
+	}
+
+	return sign + bits
+}
+
+// toleranceObjects returns the expected and actual number, their difference if it is finite, and the allowed tolerance.
+func toleranceObjects[T number](expected, actual T, name string, tolerance float64) internal.Objects {
+	objects := internal.NewObjectsExpectedActual(expected, actual)
+	e, a := float64(expected), float64(actual)
+	if !math.IsNaN(e) && !math.IsNaN(a) && !math.IsInf(e, 0) && !math.IsInf(a, 0) {
+		objects = append(objects, internal.NewObjectsSingleNamed("Actual "+name, formatFloat(math.Abs(e-a)))[0])
+	}
+
+	return append(objects, internal.NewObjectsSingleNamed("Allowed "+name, formatFloat(tolerance))[0])
+}
+
+func deltaRow[T number](key string, expected, actual T) []string {
+	delta := math.Abs(float64(expected) - float64(actual))
+
+	return []string{key, internal.FormatValue(expected), internal.FormatValue(actual), formatFloat(delta)}
+}
+
+func formatFloat(f float64) string {
+	return fmt.Sprintf("%g", f)
+}
@@ -0,0 +1,112 @@
+package assert_test
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/chalk-ai/assert"
+)
+
+func TestInDelta(t *testing.T) {
+	InDelta(t, 3.14, math.Pi, 0.01)
+	InDelta(t, 10, 12, 2)
+	InDelta(t, float32(1.5), float32(1.5), 0)
+	InDelta(t, math.NaN(), math.NaN(), 0.1)
+	InDelta(t, math.Inf(1), math.Inf(1), 0.1)
+}
+
+func TestInDelta_fails(t *testing.T) {
+	var tm testMock
+	InDelta(&tm, 1.0, 1.5, 0.1)
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "Actual Delta")
+	Contains(t, tm.ErrorMessage, "Allowed Delta")
+
+	for _, c := range []struct {
+		expected, actual, delta float64
+	}{
+		{1, math.NaN(), 1},
+		{math.NaN(), 1, 1},
+		{math.Inf(1), math.Inf(-1), math.Inf(1)},
+		{math.Inf(1), math.MaxFloat64, math.Inf(1)},
+		{1, 1, -1},
+		{1, 1, math.NaN()},
+	} {
+		TestFails(t, func(t TestingPackageWithFailFunctions) {
+			InDelta(t, c.expected, c.actual, c.delta)
+		})
+	}
+}
+
+func TestInEpsilon(t *testing.T) {
+	InEpsilon(t, 100, 101, 0.02)
+	InEpsilon(t, -100.0, -99.0, 0.01)
+	InEpsilon(t, 0, 0, 0.01)
+	InEpsilon(t, math.NaN(), math.NaN(), 0.01)
+}
+
+func TestInEpsilon_fails(t *testing.T) {
+	var tm testMock
+	InEpsilon(&tm, 100, 110, 0.05)
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "Relative Error")
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		InEpsilon(t, 0.0, 0.001, 0.5)
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		InEpsilon(t, 1.0, math.Inf(1), 0.5)
+	})
+}
+
+func TestWithinULPs(t *testing.T) {
+	WithinULPs(t, 0.3, 0.1+0.2, 1)
+	WithinULPs(t, 0.0, math.Copysign(0, -1), 0)
+	WithinULPs(t, float32(1), math.Nextafter32(1, 2), 1)
+	WithinULPs(t, -math.SmallestNonzeroFloat64, math.SmallestNonzeroFloat64, 2)
+}
+
+func TestWithinULPs_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		WithinULPs(t, 1.0, math.Nextafter(math.Nextafter(1, 2), 2), 1)
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		WithinULPs(t, float32(1), float32(1.0001), 10)
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		WithinULPs(t, math.NaN(), 1, 10)
+	})
+}
+
+func TestInDeltaSlice(t *testing.T) {
+	InDeltaSlice(t, []float64{1, 2, 3}, []float64{1.001, 1.999, 3}, 0.01)
+	InDeltaSlice(t, []int{}, []int{}, 0)
+}
+
+func TestInDeltaSlice_fails(t *testing.T) {
+	var tm testMock
+	InDeltaSlice(&tm, []float64{1, 2, 3}, []float64{1, 2.5, 4}, 0.1)
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "2 element(s)")
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		InDeltaSlice(t, []float64{1, 2}, []float64{1}, 0.1)
+	})
+}
+
+func TestInDeltaMapValues(t *testing.T) {
+	InDeltaMapValues(t, map[string]float64{"p50": 0.1, "p99": 0.25}, map[string]float64{"p50": 0.11, "p99": 0.24}, 0.05)
+}
+
+func TestInDeltaMapValues_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		InDeltaMapValues(t, map[string]float64{"p99": 0.25}, map[string]float64{"p99": 0.5}, 0.05)
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		InDeltaMapValues(t, map[string]float64{"p99": 0.25}, map[string]float64{"p50": 0.25}, 0.05)
+	})
+}
@@ -0,0 +1,125 @@
+package assert
+
+import (
+	"cmp"
+	"fmt"
+
+	"github.com/chalk-ai/assert/internal"
+)
+
+// Sorted asserts that a slice is sorted in ascending order. Equal neighbours are allowed.
+// Slices with less than two elements are always sorted.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.Sorted(t, []string{"apple", "banana", "cherry"})
+func Sorted[T cmp.Ordered](t testRunner, s []T, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	if i := firstUnordered(s, func(a, b T) bool { return cmp.Compare(a, b) <= 0 }); i >= 0 {
+		failUnordered(t, "sorted", s, i, nil, msg...)
+	}
+}
+
+// SortedFunc asserts that a slice is sorted in ascending order, as defined by the comparison function.
+// The comparison function works like the one of slices.SortFunc.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.SortedFunc(t, events, func(a, b Event) int {
+//		return a.At.Compare(b.At)
+//	})
+func SortedFunc[T any](t testRunner, s []T, compare func(a, b T) int, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	if i := firstUnordered(s, func(a, b T) bool { return compare(a, b) <= 0 }); i >= 0 {
+		failUnordered(t, "sorted", s, i, nil, msg...)
+	}
+}
+
+// SortedBy asserts that a slice is sorted in ascending order by the key of its elements.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.SortedBy(t, users, func(u User) string { return u.Name })
+func SortedBy[T any, K cmp.Ordered](t testRunner, s []T, key func(T) K, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	if i := firstUnordered(s, func(a, b T) bool { return cmp.Compare(key(a), key(b)) <= 0 }); i >= 0 {
+		failUnordered(t, "sorted", s, i, internal.Objects{
+			internal.NewObjectsSingleNamed(fmt.Sprintf("Key at index %d", i), key(s[i]))[0],
+			internal.NewObjectsSingleNamed(fmt.Sprintf("Key at index %d", i+1), key(s[i+1]))[0],
+		}, msg...)
+	}
+}
+
+// StrictlyIncreasing asserts that every element of a slice is greater than the previous one.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.StrictlyIncreasing(t, []int{1, 2, 5, 10})
+func StrictlyIncreasing[T cmp.Ordered](t testRunner, s []T, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	if i := firstUnordered(s, func(a, b T) bool { return cmp.Less(a, b) }); i >= 0 {
+		failUnordered(t, "strictly increasing", s, i, nil, msg...)
+	}
+}
+
+// NonDecreasing asserts that no element of a slice is less than the previous one.
+// It is the same check as Sorted, but reads better for sequences like timestamps or counters.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.NonDecreasing(t, []int{1, 1, 2, 3})
+func NonDecreasing[T cmp.Ordered](t testRunner, s []T, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	if i := firstUnordered(s, func(a, b T) bool { return cmp.Compare(a, b) <= 0 }); i >= 0 {
+		failUnordered(t, "non-decreasing", s, i, nil, msg...)
+	}
+}
+
+// firstUnordered returns the index of the first element that is not in order with its successor, or -1.
+func firstUnordered[T any](s []T, inOrder func(a, b T) bool) int {
+	for i := 0; i+1 < len(s); i++ {
+		if !inOrder(s[i], s[i+1]) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func failUnordered[T any](t testRunner, order string, s []T, i int, extra internal.Objects, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	objects := internal.Objects{
+		internal.NewObjectsSingleNamed(fmt.Sprintf("Element at index %d", i), s[i])[0],
+		internal.NewObjectsSingleNamed(fmt.Sprintf("Element at index %d", i+1), s[i+1])[0],
+	}
+	internal.Fail(t, fmt.Sprintf("A slice that !!should be %s!! is not: the elements at index %d and %d are out of order.", order, i, i+1),
+		append(objects, extra...), msg...)
+}
@@ -0,0 +1,74 @@
+package assert_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/chalk-ai/assert"
+)
+
+func TestSorted(t *testing.T) {
+	Sorted(t, []int{1, 2, 2, 3})
+	Sorted(t, []string{"apple", "banana", "cherry"})
+	Sorted(t, []float64{})
+	Sorted(t, []int{42})
+}
+
+func TestSorted_fails(t *testing.T) {
+	var tm testMock
+	Sorted(&tm, []string{"a", "b", "d", "c"})
+
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "index 2 and 3")
+}
+
+func TestSortedFunc(t *testing.T) {
+	times := []time.Time{testTime, testTime, testTime.Add(time.Minute)}
+	SortedFunc(t, times, func(a, b time.Time) int { return a.Compare(b) })
+}
+
+func TestSortedFunc_fails(t *testing.T) {
+	times := []time.Time{testTime, testTime.Add(time.Minute), testTime}
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		SortedFunc(t, times, func(a, b time.Time) int { return a.Compare(b) })
+	})
+}
+
+type sortedUser struct {
+	Name string
+	Age  int
+}
+
+func TestSortedBy(t *testing.T) {
+	users := []sortedUser{{"Bob", 40}, {"Alice", 30}, {"Carol", 30}}
+	SortedBy(t, users, func(u sortedUser) int { return -u.Age })
+}
+
+func TestSortedBy_fails(t *testing.T) {
+	var tm testMock
+	SortedBy(&tm, []sortedUser{{"Bob", 40}, {"Alice", 30}}, func(u sortedUser) string { return u.Name })
+
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "Key at index 1")
+}
+
+func TestStrictlyIncreasing(t *testing.T) {
+	StrictlyIncreasing(t, []int{1, 2, 5, 10})
+	StrictlyIncreasing(t, []string{"a", "b"})
+}
+
+func TestStrictlyIncreasing_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		StrictlyIncreasing(t, []int{1, 2, 2, 3})
+	})
+}
+
+func TestNonDecreasing(t *testing.T) {
+	NonDecreasing(t, []int{1, 1, 2, 3})
+}
+
+func TestNonDecreasing_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		NonDecreasing(t, []int{3, 2})
+	})
+}
@@ -0,0 +1,176 @@
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+
+	"github.com/pterm/pterm"
+
+	"github.com/chalk-ai/assert/internal"
+)
+
+// Recovered contains the result of calling a function that may panic.
+type Recovered struct {
+	// Panicked reports whether the function panicked.
+	Panicked bool
+	// Value is the value that was recovered from the panic.
+	Value any
+	// Stack is the stack trace of the panic, trimmed to the frames of the called function.
+	Stack string
+}
+
+func recoverPanic(f func()) Recovered {
+	panicked, value, stack := internal.RecoverPanic(f)
+	return Recovered{Panicked: panicked, Value: value, Stack: stack}
+}
+
+// objects returns the recovered value and the stack trace for the fail printer.
+func (r Recovered) objects() internal.Objects {
+	return internal.Objects{
+		internal.NewObjectsSingleNamed("Recovered Value", r.Value)[0],
+		{
+			Name:      "Stack",
+			NameStyle: pterm.NewStyle(pterm.FgLightRed),
+			Data:      r.Stack,
+			DataStyle: pterm.NewStyle(pterm.FgGray),
+			Raw:       true,
+		},
+	}
+}
+
+// PanicsWithValue asserts that a function panics with the expected value.
+// It returns the recovered value.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.PanicsWithValue(t, "invalid state", func() {
+//		panic("invalid state")
+//	})
+func PanicsWithValue(t testRunner, expected any, f func(), msg ...any) Recovered {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	recovered := recoverPanic(f)
+	if !recovered.Panicked {
+		internal.Fail(t, "A function that !!should panic!! did not panic.", internal.NewObjectsSingleNamed("Expected Value", expected), msg...)
+	} else if !internal.IsEqual(expected, recovered.Value) {
+		objects := append(internal.NewObjectsExpectedActualWithDiff(expected, recovered.Value), recovered.objects()[1])
+		internal.Fail(t, "A function !!panicked with an unexpected value!!.", objects, msg...)
+	}
+
+	return recovered
+}
+
+// PanicsWithError asserts that a function panics with an error that matches target.
+// If target is an error, it is matched with errors.Is.
+// If target is a pointer to an error type or an interface, it is matched with errors.As and set to the matching error.
+// It returns the recovered value.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.PanicsWithError(t, io.ErrUnexpectedEOF, func() {
+//		panic(fmt.Errorf("reading: %w", io.ErrUnexpectedEOF))
+//	})
+//
+//	var pathErr *fs.PathError
+//	assert.PanicsWithError(t, &pathErr, func() {
+//		panic(&fs.PathError{Op: "open", Path: "test.txt", Err: fs.ErrNotExist})
+//	})
+func PanicsWithError(t testRunner, target any, f func(), msg ...any) Recovered {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	recovered := recoverPanic(f)
+	if !recovered.Panicked {
+		internal.Fail(t, "A function that !!should panic!! did not panic.", internal.NewObjectsSingleNamed("Expected Error", target), msg...)
+		return recovered
+	}
+
+	err, ok := recovered.Value.(error)
+	if !ok {
+		internal.Fail(t, "A function !!panicked with a value that is not an error!!.", recovered.objects(), msg...)
+		return recovered
+	}
+
+	if !matchesErrorTarget(err, target) {
+		internal.Fail(t, "A function !!panicked with an error that does not match!! the target.", append(internal.Objects{
+			internal.NewObjectsSingleNamed("Target", target)[0],
+		}, recovered.objects()...), msg...)
+	}
+
+	return recovered
+}
+
+// PanicsMatching asserts that a function panics with a value whose text matches a regular expression.
+// Errors are matched by their message, other values by their fmt.Sprint representation.
+// It returns the recovered value.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.PanicsMatching(t, `index out of range \[\d+\]`, func() {
+//		_ = []int{}[1]
+//	})
+func PanicsMatching(t testRunner, regex string, f func(), msg ...any) Recovered {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	re, err := regexp.Compile(regex)
+	if err != nil {
+		internal.Fail(t, "The regex pattern !!is invalid!!.", internal.Objects{
+			internal.NewObjectsSingleNamed("Regex Pattern", regex+"\n")[0],
+			internal.NewObjectsSingleNamed("Error", err.Error()+"\n")[0],
+		}, msg...)
+		return Recovered{}
+	}
+
+	recovered := recoverPanic(f)
+	if !recovered.Panicked {
+		internal.Fail(t, "A function that !!should panic!! did not panic.", internal.NewObjectsSingleNamed("Regex Pattern", regex+"\n"), msg...)
+		return recovered
+	}
+
+	text := fmt.Sprint(recovered.Value)
+	if err, ok := recovered.Value.(error); ok {
+		text = err.Error()
+	}
+
+	if !re.MatchString(text) {
+		internal.Fail(t, "A function !!panicked with a value that does not match!! the regex pattern.", append(internal.Objects{
+			internal.NewObjectsSingleNamed("Regex Pattern", regex+"\n")[0],
+		}, recovered.objects()...), msg...)
+	}
+
+	return recovered
+}
+
+// matchesErrorTarget matches err against an error with errors.Is, or against a pointer to an error type with errors.As.
+// A target that is an error itself is only matched with errors.Is, even if it is a pointer,
+// as errors.As would overwrite it.
+func matchesErrorTarget(err error, target any) bool {
+	if targetErr, ok := target.(error); ok {
+		return errors.Is(err, targetErr)
+	}
+
+	v := reflect.ValueOf(target)
+	if !v.IsValid() || v.Kind() != reflect.Pointer || v.IsNil() {
+		return false
+	}
+
+	elem := v.Type().Elem()
+	if elem.Kind() != reflect.Interface && !elem.Implements(reflect.TypeFor[error]()) {
+		return false
+	}
+
+	return errors.As(err, target)
+}
@@ -0,0 +1,134 @@
+package assert_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"testing"
+
+	. "github.com/chalk-ai/assert"
+)
+
+func TestPanics_recovered(t *testing.T) {
+	recovered := Panics(t, func() {
+		panic("TestPanic")
+	})
+
+	True(t, recovered.Panicked)
+	Equal(t, "TestPanic", recovered.Value)
+	Contains(t, recovered.Stack, "TestPanics_recovered")
+	NotContains(t, recovered.Stack, "runtime/debug.Stack")
+}
+
+func TestNotPanics_fails_output(t *testing.T) {
+	var tm testMock
+	NotPanics(&tm, func() {
+		panic("unexpected panic value")
+	})
+
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "unexpected panic value")
+	Contains(t, tm.ErrorMessage, "TestNotPanics_fails_output")
+}
+
+func TestPanicsWithValue(t *testing.T) {
+	PanicsWithValue(t, "invalid state", func() {
+		panic("invalid state")
+	})
+	PanicsWithValue(t, 42, func() {
+		panic(42)
+	})
+}
+
+func TestPanicsWithValue_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		PanicsWithValue(t, "invalid state", func() {
+			panic("other state")
+		})
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		PanicsWithValue(t, "invalid state", func() {})
+	})
+}
+
+func TestPanicsWithError(t *testing.T) {
+	PanicsWithError(t, io.ErrUnexpectedEOF, func() {
+		panic(fmt.Errorf("reading: %w", io.ErrUnexpectedEOF))
+	})
+
+	var pathErr *fs.PathError
+	PanicsWithError(t, &pathErr, func() {
+		panic(fmt.Errorf("wrapped: %w", &fs.PathError{Op: "open", Path: "test.txt", Err: fs.ErrNotExist}))
+	})
+	Equal(t, "test.txt", pathErr.Path)
+}
+
+type panicsTestError struct {
+	code int
+}
+
+func (e panicsTestError) Error() string {
+	return fmt.Sprintf("code %d", e.code)
+}
+
+var errPanicsTestSentinel = &panicsTestError{code: 1}
+
+func TestPanicsWithError_pointer_sentinel_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		PanicsWithError(t, errPanicsTestSentinel, func() {
+			panic(panicsTestError{code: 2})
+		})
+	})
+
+	Equal(t, 1, errPanicsTestSentinel.code)
+}
+
+func TestPanicsWithError_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		PanicsWithError(t, io.EOF, func() {
+			panic(errors.New("other error"))
+		})
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		PanicsWithError(t, io.EOF, func() {
+			panic("not an error")
+		})
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		var pathErr *fs.PathError
+		PanicsWithError(t, &pathErr, func() {
+			panic(io.EOF)
+		})
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		PanicsWithError(t, io.EOF, func() {})
+	})
+}
+
+func TestPanicsMatching(t *testing.T) {
+	PanicsMatching(t, `index out of range \[\d+\]`, func() {
+		_ = []int{}[len("x")]
+	})
+	PanicsMatching(t, `^code \d+$`, func() {
+		panic(fmt.Sprintf("code %d", 42))
+	})
+}
+
+func TestPanicsMatching_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		PanicsMatching(t, `^foo$`, func() {
+			panic("bar")
+		})
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		PanicsMatching(t, `(`, func() {
+			panic("(")
+		})
+	})
+}
@@ -0,0 +1,603 @@
+package assert
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/chalk-ai/assert/internal"
+)
+
+// ScriptCommand is a command, that can be used in the scripts run by RunScript.
+// The arguments do not contain the name of the command. negated reports if the command was prefixed with "!".
+// Commands fail by calling assertions or Error on the passed *Script.
+type ScriptCommand func(s *Script, negated bool, args []string)
+
+// ScriptOption customizes how RunScript and RunScripts run scripts.
+type ScriptOption func(opts *scriptOptions)
+
+type scriptOptions struct {
+	update   bool
+	commands map[string]ScriptCommand
+}
+
+// UpdateScripts enables the update mode, in which a failing "cmp" command whose second file is part of the archive
+// does not fail, but rewrites that file in the archive with the content of the first file.
+//
+// Example:
+//
+//	assert.RunScripts(t, "testdata/scripts/*.txtar", assert.UpdateScripts(os.Getenv("UPDATE_SCRIPTS") != ""))
+func UpdateScripts(enabled bool) ScriptOption {
+	return func(opts *scriptOptions) {
+		opts.update = enabled
+	}
+}
+
+// ScriptCommands adds custom commands to the scripts. They take precedence over the built-in commands with the same name.
+//
+// Example:
+//
+//	assert.RunScript(t, "testdata/serve.txtar", assert.ScriptCommands(map[string]assert.ScriptCommand{
+//		"healthy": func(s *assert.Script, negated bool, args []string) {
+//			assert.NoError(s, server.Ping())
+//		},
+//	}))
+func ScriptCommands(commands map[string]ScriptCommand) ScriptOption {
+	return func(opts *scriptOptions) {
+		for name, command := range commands {
+			opts.commands[name] = command
+		}
+	}
+}
+
+// Script is the state of a script, which is run by RunScript.
+// It can be passed to every assertion in place of a *testing.T, failures are reported with the current line of the script.
+type Script struct {
+	t       testRunner
+	file    string
+	archive internal.Archive
+	options scriptOptions
+
+	work    string
+	dir     string
+	env     []string
+	stdout  string
+	stderr  string
+	line    int
+	command string
+	failed  bool
+	updated bool
+}
+
+// scriptStop is used by FailNow to stop the current command.
+type scriptStop struct{}
+
+// RunScript runs a script test, which is stored in a txtar archive.
+// The files of the archive are written to a new temporary directory of the test, which is available as $WORK.
+// The comment section of the archive is the script. Every line contains one command, lines starting with # are comments.
+// Arguments are separated by spaces, can be quoted with single quotes and can contain environment variables like $WORK.
+// A command prefixed with "!" is negated. The script stops at the first failing command.
+//
+// Commands:
+//
+//	exec program [args...]    runs a program, which has to succeed, or fail if negated
+//	stdout pattern            matches the stdout of the last exec with a regular expression, or checks that it does not match
+//	stderr pattern            matches the stderr of the last exec with a regular expression, or checks that it does not match
+//	cmp file expected         compares two files, "stdout" and "stderr" refer to the output of the last exec
+//	env KEY=VALUE...          sets environment variables
+//	cd dir                    changes the working directory
+//
+// In addition, the assertions Equal, NotEqual, Contains, NotContains, Regexp, NotRegexp, FileContent, FileExists,
+// NoFileExists, DirExists, NoDirExists, DirEmpty and DirNotEmpty can be used as commands with string arguments.
+// Relative paths are resolved from the working directory.
+//
+// Example script:
+//
+//	exec mycli greet gopher
+//	stdout '^Hello, gopher!$'
+//	! stderr .
+//	cmp stdout want.txt
+//	FileExists mycli.log
+//
+//	-- want.txt --
+//	Hello, gopher!
+//
+// Example:
+//
+//	assert.RunScript(t, "testdata/greet.txtar")
+func RunScript(t testRunner, file string, opts ...ScriptOption) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	test, ok := t.(tempDirer)
+	if !ok {
+		internal.Fail(t, "The test !!does not support TempDir!!, which is needed to run a script.", internal.Objects{})
+		return
+	}
+
+	content, err := os.ReadFile(file)
+	if err != nil {
+		internal.Fail(t, "The script !!could not be read!!.", internal.Objects{internal.NewErrorChainObject("Error", err)})
+		return
+	}
+
+	s := &Script{
+		t:       t,
+		file:    file,
+		archive: internal.ParseArchive(string(content)),
+		options: newScriptOptions(opts),
+		work:    test.TempDir(),
+	}
+	s.dir = s.work
+	if err := s.setup(); err != nil {
+		internal.Fail(t, "The files of the script !!could not be written!!.", internal.Objects{internal.NewErrorChainObject("Error", err)})
+		return
+	}
+
+	for i, line := range strings.Split(s.archive.Comment, "\n") {
+		s.line = i + 1
+		s.run(strings.TrimSpace(line))
+		if s.failed {
+			break
+		}
+	}
+
+	if s.updated {
+		if err := os.WriteFile(file, []byte(internal.FormatArchive(s.archive)), 0644); err != nil {
+			internal.Fail(t, "The script !!could not be updated!!.", internal.Objects{internal.NewErrorChainObject("Error", err)})
+		}
+	}
+}
+
+// RunScripts runs every script matching a glob pattern with RunScript, each in its own subtest named after the file.
+//
+// Example:
+//
+//	func TestCLI(t *testing.T) {
+//		assert.RunScripts(t, "testdata/scripts/*.txtar")
+//	}
+func RunScripts(t *testing.T, pattern string, opts ...ScriptOption) {
+	t.Helper()
+
+	files, err := filepath.Glob(pattern)
+	if err != nil || len(files) == 0 {
+		internal.Fail(t, fmt.Sprintf("The pattern %q !!does not match any scripts!!.", pattern), internal.Objects{})
+		return
+	}
+
+	for _, file := range files {
+		t.Run(strings.TrimSuffix(filepath.Base(file), filepath.Ext(file)), func(t *testing.T) {
+			RunScript(t, file, opts...)
+		})
+	}
+}
+
+// Error reports a failure of the current command, together with the line of the script.
+func (s *Script) Error(args ...any) {
+	if test, ok := s.t.(helper); ok {
+		test.Helper()
+	}
+
+	s.failed = true
+	s.t.Error(fmt.Sprintf("%s:%d: %s\n", s.file, s.line, s.command) + fmt.Sprint(args...))
+}
+
+// FailNow stops the current command, which stops the script.
+func (s *Script) FailNow() {
+	s.failed = true
+	panic(scriptStop{})
+}
+
+// Dir returns the current working directory of the script.
+func (s *Script) Dir() string {
+	return s.dir
+}
+
+// Path resolves a path relative to the working directory of the script.
+func (s *Script) Path(name string) string {
+	if filepath.IsAbs(name) {
+		return name
+	}
+
+	return filepath.Join(s.dir, filepath.FromSlash(name))
+}
+
+// Getenv returns the value of an environment variable of the script.
+func (s *Script) Getenv(key string) string {
+	for i := len(s.env) - 1; i >= 0; i-- {
+		if k, v, _ := strings.Cut(s.env[i], "="); k == key {
+			return v
+		}
+	}
+
+	return ""
+}
+
+// Setenv sets an environment variable of the script, which is passed to every following exec.
+func (s *Script) Setenv(key, value string) {
+	for i, kv := range s.env {
+		if k, _, _ := strings.Cut(kv, "="); k == key {
+			s.env[i] = key + "=" + value
+			return
+		}
+	}
+
+	s.env = append(s.env, key+"="+value)
+}
+
+// Stdout returns the stdout of the last exec.
+func (s *Script) Stdout() string {
+	return s.stdout
+}
+
+// Stderr returns the stderr of the last exec.
+func (s *Script) Stderr() string {
+	return s.stderr
+}
+
+// ReadFile returns the content of a file relative to the working directory.
+// The names "stdout" and "stderr" refer to the output of the last exec. If the file cannot be read, the command fails.
+func (s *Script) ReadFile(name string) string {
+	switch name {
+	case "stdout":
+		return s.stdout
+	case "stderr":
+		return s.stderr
+	}
+
+	content, err := os.ReadFile(s.Path(name))
+	if err != nil {
+		internal.Fail(s, fmt.Sprintf("The file %q !!could not be read!!.", name), internal.Objects{internal.NewErrorChainObject("Error", err)})
+		s.FailNow()
+	}
+
+	return strings.ReplaceAll(string(content), "\r\n", "\n")
+}
+
+func newScriptOptions(opts []ScriptOption) scriptOptions {
+	options := scriptOptions{commands: map[string]ScriptCommand{}}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return options
+}
+
+// setup writes the files of the archive and prepares the environment.
+func (s *Script) setup() error {
+	for _, f := range s.archive.Files {
+		name := filepath.Join(s.work, filepath.FromSlash(f.Name))
+		if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(name, []byte(f.Data), 0644); err != nil {
+			return err
+		}
+	}
+
+	tmp := filepath.Join(s.work, ".tmp")
+	if err := os.MkdirAll(tmp, 0755); err != nil {
+		return err
+	}
+
+	s.env = []string{
+		"PATH=" + os.Getenv("PATH"),
+		"HOME=" + s.work,
+		"TMPDIR=" + tmp,
+		"WORK=" + s.work,
+		"PWD=" + s.work,
+	}
+
+	return nil
+}
+
+// run parses and runs a single line of the script.
+func (s *Script) run(line string) {
+	if line == "" || strings.HasPrefix(line, "#") {
+		return
+	}
+	s.command = line
+
+	negated := false
+	if rest, ok := strings.CutPrefix(line, "!"); ok {
+		negated = true
+		line = strings.TrimSpace(rest)
+	}
+
+	args, err := s.parseArgs(line)
+	if err != nil || len(args) == 0 {
+		if err == nil {
+			err = errors.New("missing command")
+		}
+		internal.Fail(s, "The line of the script !!could not be parsed!!.", internal.Objects{internal.NewErrorChainObject("Error", err)})
+		return
+	}
+
+	command, ok := s.options.commands[args[0]]
+	if !ok {
+		command, ok = scriptCommands[args[0]]
+	}
+	if !ok {
+		internal.Fail(s, fmt.Sprintf("The command %q !!is unknown!!.", args[0]), internal.Objects{})
+		return
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(scriptStop); !ok {
+				panic(r)
+			}
+		}
+	}()
+	command(s, negated, args[1:])
+}
+
+// parseArgs splits a line into arguments. Single quotes group an argument, two single quotes inside of them are a literal quote.
+// Environment variables are expanded outside of quotes.
+func (s *Script) parseArgs(line string) ([]string, error) {
+	var args []string
+	var current strings.Builder
+	inArg, quoted := false, false
+
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case quoted:
+			if c != '\'' {
+				current.WriteByte(c)
+			} else if i+1 < len(line) && line[i+1] == '\'' {
+				current.WriteByte('\'')
+				i++
+			} else {
+				quoted = false
+			}
+		case c == '\'':
+			quoted, inArg = true, true
+		case c == ' ' || c == '\t':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		case c == '$':
+			inArg = true
+			name, n := scriptVariable(line[i+1:])
+			if n == 0 {
+				current.WriteByte(c)
+				continue
+			}
+			current.WriteString(s.Getenv(name))
+			i += n
+		default:
+			inArg = true
+			current.WriteByte(c)
+		}
+	}
+
+	if quoted {
+		return nil, errors.New("unterminated quote")
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+
+	return args, nil
+}
+
+// scriptVariable returns the name of the variable at the start of s, in the form NAME or {NAME}, and its length.
+func scriptVariable(s string) (string, int) {
+	if strings.HasPrefix(s, "{") {
+		if end := strings.IndexByte(s, '}'); end > 1 {
+			return s[1:end], end + 1
+		}
+		return "", 0
+	}
+
+	n := 0
+	for n < len(s) && (s[n] == '_' || 'a' <= s[n] && s[n] <= 'z' || 'A' <= s[n] && s[n] <= 'Z' || '0' <= s[n] && s[n] <= '9') {
+		n++
+	}
+
+	return s[:n], n
+}
+
+// checkArgs fails the command, if the number of arguments is not between min and max. A negative max means no limit.
+func (s *Script) checkArgs(args []string, min, max int) bool {
+	if len(args) >= min && (max < 0 || len(args) <= max) {
+		return true
+	}
+
+	expected := fmt.Sprintf("%d", min)
+	if max < 0 {
+		expected = fmt.Sprintf("at least %d", min)
+	} else if max != min {
+		expected = fmt.Sprintf("%d to %d", min, max)
+	}
+	internal.Fail(s, fmt.Sprintf("The command !!expects %s argument(s)!!, but got %d.", expected, len(args)), internal.Objects{})
+
+	return false
+}
+
+func (s *Script) notNegated(negated bool) bool {
+	if negated {
+		internal.Fail(s, "The command !!cannot be negated!!.", internal.Objects{})
+	}
+
+	return !negated
+}
+
+var scriptCommands = map[string]ScriptCommand{
+	"exec":   scriptExec,
+	"stdout": scriptOutputMatcher("stdout", (*Script).Stdout),
+	"stderr": scriptOutputMatcher("stderr", (*Script).Stderr),
+	"cmp":    scriptCmp,
+	"env":    scriptEnv,
+	"cd":     scriptCd,
+
+	"Equal":        scriptAssertion(2, func(s *Script, args []string) { Equal(s, args[0], args[1]) }),
+	"NotEqual":     scriptAssertion(2, func(s *Script, args []string) { NotEqual(s, args[0], args[1]) }),
+	"Contains":     scriptAssertion(2, func(s *Script, args []string) { Contains(s, args[0], args[1]) }),
+	"NotContains":  scriptAssertion(2, func(s *Script, args []string) { NotContains(s, args[0], args[1]) }),
+	"Regexp":       scriptAssertion(2, func(s *Script, args []string) { Regexp(s, args[0], args[1]) }),
+	"NotRegexp":    scriptAssertion(2, func(s *Script, args []string) { NotRegexp(s, args[0], args[1]) }),
+	"FileContent":  scriptAssertion(2, func(s *Script, args []string) { FileContent(s, s.Path(args[0]), args[1]) }),
+	"FileExists":   scriptAssertion(1, func(s *Script, args []string) { FileExists(s, s.Path(args[0])) }),
+	"NoFileExists": scriptAssertion(1, func(s *Script, args []string) { NoFileExists(s, s.Path(args[0])) }),
+	"DirExists":    scriptAssertion(1, func(s *Script, args []string) { DirExists(s, s.Path(args[0])) }),
+	"NoDirExists":  scriptAssertion(1, func(s *Script, args []string) { NoDirExists(s, s.Path(args[0])) }),
+	"DirEmpty":     scriptAssertion(1, func(s *Script, args []string) { DirEmpty(s, s.Path(args[0])) }),
+	"DirNotEmpty":  scriptAssertion(1, func(s *Script, args []string) { DirNotEmpty(s, s.Path(args[0])) }),
+}
+
+// scriptAssertion turns an assertion into a command, which takes exactly n arguments and cannot be negated.
+func scriptAssertion(n int, assertion func(s *Script, args []string)) ScriptCommand {
+	return func(s *Script, negated bool, args []string) {
+		if s.notNegated(negated) && s.checkArgs(args, n, n) {
+			assertion(s, args)
+		}
+	}
+}
+
+func scriptExec(s *Script, negated bool, args []string) {
+	if !s.checkArgs(args, 1, -1) {
+		return
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Dir = s.dir
+	cmd.Env = s.env
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	s.stdout, s.stderr = stdout.String(), stderr.String()
+
+	var exitErr *exec.ExitError
+	if err != nil && !errors.As(err, &exitErr) {
+		internal.Fail(s, "The program !!could not be run!!.", internal.Objects{internal.NewErrorChainObject("Error", err)})
+		return
+	}
+
+	if err == nil && negated {
+		internal.Fail(s, "The program !!succeeded!!, but should fail.", s.outputObjects())
+	} else if err != nil && !negated {
+		internal.Fail(s, fmt.Sprintf("The program !!failed with exit code %d!!, but should succeed.", exitErr.ExitCode()), s.outputObjects())
+	}
+}
+
+func scriptOutputMatcher(name string, output func(s *Script) string) ScriptCommand {
+	return func(s *Script, negated bool, args []string) {
+		if !s.checkArgs(args, 1, 1) {
+			return
+		}
+
+		re, err := regexp.Compile("(?m)" + args[0])
+		if err != nil {
+			internal.Fail(s, "The regex pattern !!is invalid!!.", internal.NewInvalidRegexpObjects(args[0], err))
+			return
+		}
+
+		text := output(s)
+		if re.MatchString(text) == negated {
+			failText := "!!does not match!! the pattern."
+			if negated {
+				failText = "!!does match!! the pattern, but !!should not!!."
+			}
+			internal.Fail(s, "The "+name+" of the program "+failText, internal.Objects{
+				internal.NewObjectsSingleNamed("Regex Pattern", args[0]+"\n")[0],
+				internal.NewObjectsSingleNamed(strings.ToUpper(name[:1])+name[1:], text)[0],
+			})
+		}
+	}
+}
+
+func scriptCmp(s *Script, negated bool, args []string) {
+	if !s.checkArgs(args, 2, 2) {
+		return
+	}
+
+	actual, expected := s.ReadFile(args[0]), s.ReadFile(args[1])
+	if actual == expected {
+		if negated {
+			internal.Fail(s, fmt.Sprintf("The files %q and %q !!are equal!!, but should not be.", args[0], args[1]), internal.Objects{})
+		}
+		return
+	}
+	if negated {
+		return
+	}
+
+	if name, ok := s.archiveFileName(args[1]); ok && s.options.update {
+		for i, f := range s.archive.Files {
+			if f.Name == name {
+				s.archive.Files[i].Data = actual
+			}
+		}
+		s.updated = true
+		if err := os.WriteFile(s.Path(args[1]), []byte(actual), 0644); err != nil {
+			internal.Fail(s, fmt.Sprintf("The file %q !!could not be updated!!.", args[1]), internal.Objects{internal.NewErrorChainObject("Error", err)})
+		}
+		return
+	}
+
+	object := internal.NewLineDiffObject(strings.TrimSuffix(expected, "\n"), strings.TrimSuffix(actual, "\n"))
+	internal.Fail(s, fmt.Sprintf("The files %q and %q !!are not equal!!.", args[0], args[1]), internal.Objects{object})
+}
+
+// archiveFileName returns the name of the archive file, that a path refers to.
+func (s *Script) archiveFileName(name string) (string, bool) {
+	rel, err := filepath.Rel(s.work, s.Path(name))
+	if err != nil {
+		return "", false
+	}
+
+	rel = filepath.ToSlash(rel)
+	_, ok := s.archive.File(rel)
+
+	return rel, ok
+}
+
+func scriptEnv(s *Script, negated bool, args []string) {
+	if !s.notNegated(negated) || !s.checkArgs(args, 1, -1) {
+		return
+	}
+
+	for _, arg := range args {
+		key, value, ok := strings.Cut(arg, "=")
+		if !ok || key == "" {
+			internal.Fail(s, fmt.Sprintf("The argument %q !!is not in the form KEY=VALUE!!.", arg), internal.Objects{})
+			return
+		}
+		s.Setenv(key, value)
+	}
+}
+
+func scriptCd(s *Script, negated bool, args []string) {
+	if !s.notNegated(negated) || !s.checkArgs(args, 1, 1) {
+		return
+	}
+
+	dir := s.Path(args[0])
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		internal.Fail(s, fmt.Sprintf("The directory %q !!does not exist!!.", args[0]), internal.Objects{})
+		return
+	}
+
+	s.dir = dir
+	s.Setenv("PWD", dir)
+}
+
+func (s *Script) outputObjects() internal.Objects {
+	return internal.Objects{
+		internal.NewObjectsSingleNamed("Stdout", s.stdout)[0],
+		internal.NewObjectsSingleNamed("Stderr", s.stderr)[0],
+	}
+}
@@ -0,0 +1,118 @@
+package assert_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	. "github.com/chalk-ai/assert"
+)
+
+type scriptMock struct {
+	testMock
+	dir string
+}
+
+func (m *scriptMock) TempDir() string {
+	return m.dir
+}
+
+func writeScript(t *testing.T, content string) string {
+	t.Helper()
+
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh is not available")
+	}
+
+	file := filepath.Join(t.TempDir(), "script.txtar")
+	NoError(t, os.WriteFile(file, []byte(content), 0644))
+
+	return file
+}
+
+func TestRunScripts(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh is not available")
+	}
+
+	RunScripts(t, "testdata/scripts/*.txtar")
+}
+
+func TestRunScript_fails(t *testing.T) {
+	file := writeScript(t, "exec echo hello\nstdout goodbye\nexec false\n")
+
+	tm := scriptMock{dir: t.TempDir()}
+	RunScript(&tm, file)
+
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, file+":2: stdout goodbye")
+	Contains(t, tm.ErrorMessage, "does not match")
+}
+
+func TestRunScript_exec_fails(t *testing.T) {
+	file := writeScript(t, "# comment\n\nexec sh -c 'exit 3'\n")
+
+	tm := scriptMock{dir: t.TempDir()}
+	RunScript(&tm, file)
+
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, file+":3:")
+	Contains(t, tm.ErrorMessage, "failed with exit code 3")
+}
+
+func TestRunScript_assertion_fails(t *testing.T) {
+	file := writeScript(t, "Equal a b\n")
+
+	tm := scriptMock{dir: t.TempDir()}
+	RunScript(&tm, file)
+
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, file+":1: Equal a b")
+}
+
+func TestRunScript_unknown_command(t *testing.T) {
+	file := writeScript(t, "frobnicate\n")
+
+	tm := scriptMock{dir: t.TempDir()}
+	RunScript(&tm, file)
+
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "is unknown")
+}
+
+func TestRunScript_cmp_fails(t *testing.T) {
+	file := writeScript(t, "exec echo new\ncmp stdout want.txt\n-- want.txt --\nold\n")
+
+	tm := scriptMock{dir: t.TempDir()}
+	RunScript(&tm, file)
+
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "are not equal")
+}
+
+func TestRunScript_update(t *testing.T) {
+	file := writeScript(t, "exec echo new\ncmp stdout want.txt\n-- want.txt --\nold\n")
+
+	RunScript(t, file, UpdateScripts(true))
+
+	FileContent(t, file, "exec echo new\ncmp stdout want.txt\n-- want.txt --\nnew\n")
+	RunScript(t, file)
+}
+
+func TestRunScript_custom_command(t *testing.T) {
+	commands := ScriptCommands(map[string]ScriptCommand{
+		"greets": func(s *Script, negated bool, args []string) {
+			Equal(s, "gopher", args[0])
+		},
+	})
+
+	RunScript(t, writeScript(t, "greets gopher\n"), commands)
+
+	file := writeScript(t, "greets gopher\ngreets alice\n")
+	tm := scriptMock{dir: t.TempDir()}
+	RunScript(&tm, file, commands)
+
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, file+":2: greets alice")
+}
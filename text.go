@@ -0,0 +1,259 @@
+package assert
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/chalk-ai/assert/internal"
+)
+
+// LinesOption customizes the comparison of LinesEqual.
+type LinesOption func(opts *linesOptions)
+
+type linesOptions struct {
+	ignoreTrailingWhitespace bool
+	ignoreLineEndings        bool
+	ignoreBlankLines         bool
+}
+
+// IgnoreTrailingWhitespace ignores spaces and tabs at the end of every line.
+//
+// Example:
+//
+//	assert.LinesEqual(t, "a\nb", "a  \nb\t", assert.IgnoreTrailingWhitespace())
+func IgnoreTrailingWhitespace() LinesOption {
+	return func(opts *linesOptions) {
+		opts.ignoreTrailingWhitespace = true
+	}
+}
+
+// IgnoreLineEndings treats "\r\n", "\r" and "\n" as the same line ending.
+//
+// Example:
+//
+//	assert.LinesEqual(t, "a\nb", "a\r\nb", assert.IgnoreLineEndings())
+func IgnoreLineEndings() LinesOption {
+	return func(opts *linesOptions) {
+		opts.ignoreLineEndings = true
+	}
+}
+
+// IgnoreBlankLines skips lines that are empty or contain only whitespace.
+//
+// Example:
+//
+//	assert.LinesEqual(t, "a\nb", "a\n\n  \nb\n", assert.IgnoreBlankLines())
+func IgnoreBlankLines() LinesOption {
+	return func(opts *linesOptions) {
+		opts.ignoreBlankLines = true
+	}
+}
+
+// LinesEqual asserts that two texts have the same lines.
+// LinesOptions can be passed before the custom message, to ignore trailing whitespace, line endings or blank lines.
+// The failure shows a diff, in which changed lines are aligned by their line number.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.LinesEqual(t, "first\nsecond\n", output, assert.IgnoreTrailingWhitespace(), assert.IgnoreLineEndings())
+func LinesEqual(t testRunner, expected string, actual string, opts ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	var options linesOptions
+	var msg []any
+	for i, opt := range opts {
+		if o, ok := opt.(LinesOption); ok {
+			o(&options)
+			continue
+		}
+		msg = opts[i:]
+		break
+	}
+
+	expected = strings.Join(normalizeLines(expected, options), "\n")
+	actual = strings.Join(normalizeLines(actual, options), "\n")
+	if expected != actual {
+		internal.Fail(t, "Two texts that !!should have equal lines!!, do not have equal lines.", internal.Objects{
+			internal.NewLineDiffObject(expected, actual),
+		}, msg...)
+	}
+}
+
+// ContainsLines asserts that a text contains the lines in the given order.
+// Other lines may appear before, between and after them.
+// A newline at the end of the text does not start an additional line.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.ContainsLines(t, log, []string{"starting server", "listening on :8080"})
+func ContainsLines(t testRunner, text string, lines []string, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	textLines := splitLines(text)
+	next := 0
+	for i, line := range lines {
+		found := false
+		for next < len(textLines) {
+			next++
+			if textLines[next-1] == line {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			internal.Fail(t, fmt.Sprintf("A text !!does not contain the line %d of %d!! after the previous lines.", i+1, len(lines)), internal.Objects{
+				internal.NewObjectsSingleNamed("Missing Line", line)[0],
+				internal.NewObjectsSingleNamed("Found Lines", lines[:i])[0],
+				numberedTextObject("Text", text),
+			}, msg...)
+			return
+		}
+	}
+}
+
+// LinesMatch asserts that every line of a text matches the pattern at the same position.
+// A pattern is a glob, in which "*" matches any sequence of characters and "?" matches a single character.
+// Patterns with the prefix "re:" are regular expressions instead. Every pattern has to match the whole line.
+// Every line that does not match is reported. A newline at the end of the text does not start an additional line.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.LinesMatch(t, []string{"Starting *", `re:^listening on :\d+$`, "done"}, output)
+func LinesMatch(t testRunner, patterns []string, text string, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	lines := splitLines(text)
+	if len(lines) != len(patterns) {
+		internal.Fail(t, fmt.Sprintf("A text !!has %d line(s)!!, but %d pattern(s) were given.", len(lines), len(patterns)), internal.Objects{
+			numberedTextObject("Text", text),
+		}, msg...)
+		return
+	}
+
+	var rows [][]string
+	for i, pattern := range patterns {
+		re, err := linePattern(pattern)
+		if err != nil {
+			rows = append(rows, []string{fmt.Sprint(i + 1), pattern, lines[i], "invalid pattern: " + err.Error()})
+		} else if !re.MatchString(lines[i]) {
+			rows = append(rows, []string{fmt.Sprint(i + 1), pattern, lines[i], ""})
+		}
+	}
+
+	if len(rows) > 0 {
+		internal.Fail(t, fmt.Sprintf("%d line(s) !!do not match!! their pattern.", len(rows)), internal.Objects{
+			internal.NewTableObject("Mismatches", []string{"Line", "Pattern", "Actual", "Error"}, rows),
+		}, msg...)
+	}
+}
+
+// TextEqualIgnoringIndent asserts that two texts are equal, when the leading whitespace of every line is ignored.
+// Unlike EqualDedent, lines do not have to keep their relative indentation.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.TextEqualIgnoringIndent(t, "if x {\n\treturn\n}", "if x {\n    return\n}")
+func TextEqualIgnoringIndent(t testRunner, expected string, actual string, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	if trimIndent(expected) != trimIndent(actual) {
+		internal.Fail(t, "Two texts that !!should be equal ignoring indentation!!, are not equal.", internal.Objects{
+			internal.NewLineDiffObject(expected, actual),
+		}, msg...)
+	}
+}
+
+func normalizeLines(text string, opts linesOptions) []string {
+	if opts.ignoreLineEndings {
+		text = strings.ReplaceAll(strings.ReplaceAll(text, "\r\n", "\n"), "\r", "\n")
+	}
+
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		if opts.ignoreTrailingWhitespace {
+			line = strings.TrimRight(line, " \t")
+		}
+		if opts.ignoreBlankLines && strings.TrimSpace(line) == "" {
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+// splitLines splits a text into lines, ignoring one newline at the end of the text.
+func splitLines(text string) []string {
+	if trimmed, ok := strings.CutSuffix(text, "\n"); ok {
+		text = strings.TrimSuffix(trimmed, "\r")
+	}
+
+	return strings.Split(text, "\n")
+}
+
+func trimIndent(text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimLeft(line, " \t")
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// linePattern compiles a glob, or a regular expression with the prefix "re:", into a regular expression that matches whole lines.
+func linePattern(pattern string) (*regexp.Regexp, error) {
+	if expr, ok := strings.CutPrefix(pattern, "re:"); ok {
+		return regexp.Compile("^(?:" + expr + ")$")
+	}
+
+	var expr strings.Builder
+	expr.WriteString("^")
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			expr.WriteString(".*")
+		case '?':
+			expr.WriteString(".")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	expr.WriteString("$")
+
+	return regexp.Compile(expr.String())
+}
+
+// numberedTextObject shows a text with line numbers.
+func numberedTextObject(name string, text string) internal.Object {
+	lines := strings.Split(text, "\n")
+	width := len(fmt.Sprint(len(lines)))
+
+	var data strings.Builder
+	for i, line := range lines {
+		data.WriteString(fmt.Sprintf("%*d| %s\n", width, i+1, line))
+	}
+
+	object := internal.NewObjectsSingleNamed(name, nil)[0]
+	object.Data = data.String()
+	object.Raw = true
+
+	return object
+}
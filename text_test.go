@@ -0,0 +1,90 @@
+package assert_test
+
+import (
+	"testing"
+
+	. "github.com/chalk-ai/assert"
+)
+
+func TestLinesEqual(t *testing.T) {
+	LinesEqual(t, "a\nb", "a\nb")
+	LinesEqual(t, "a\nb", "a  \nb\t", IgnoreTrailingWhitespace())
+	LinesEqual(t, "a\nb", "a\r\nb", IgnoreLineEndings())
+	LinesEqual(t, "a\nb", "\na\n\n  \nb\n", IgnoreBlankLines())
+	LinesEqual(t, "a\nb\n", "a \r\n\r\nb\r\n", IgnoreTrailingWhitespace(), IgnoreLineEndings(), IgnoreBlankLines())
+}
+
+func TestLinesEqual_fails(t *testing.T) {
+	var tm testMock
+	LinesEqual(&tm, "first\nsecond\nthird", "first\nchanged\nthird", IgnoreTrailingWhitespace(), "custom message")
+
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "custom message")
+	Contains(t, tm.ErrorMessage, "2. ")
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		LinesEqual(t, "a\nb", "a\r\nb")
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		LinesEqual(t, "a\nb", "a\n\nb", IgnoreTrailingWhitespace())
+	})
+}
+
+func TestContainsLines(t *testing.T) {
+	log := "starting server\nloading config\nlistening on :8080\nready"
+
+	ContainsLines(t, log, []string{"starting server", "listening on :8080"})
+	ContainsLines(t, log, nil)
+	ContainsLines(t, "a\nb\n", []string{"a", "b"})
+}
+
+func TestContainsLines_fails(t *testing.T) {
+	log := "starting server\nloading config\nlistening on :8080"
+
+	var tm testMock
+	ContainsLines(&tm, log, []string{"listening on :8080", "starting server"})
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "line 2 of 2")
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		ContainsLines(t, log, []string{"stopping server"})
+	})
+}
+
+func TestLinesMatch(t *testing.T) {
+	LinesMatch(t, []string{"Starting *", `re:listening on :\d+`, "done?"}, "Starting v1.2\nlistening on :8080\ndone.")
+	LinesMatch(t, []string{"a.b"}, "a.b")
+	LinesMatch(t, []string{"Starting *", "done"}, "Starting v1\ndone\n")
+	LinesMatch(t, []string{"Starting *", "done"}, "Starting v1\ndone\r\n")
+}
+
+func TestLinesMatch_fails(t *testing.T) {
+	var tm testMock
+	LinesMatch(&tm, []string{"Starting *", `re:listening on :\d+`, "a.b"}, "Stopping\nlistening on :http\naxb")
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "3 line(s)")
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		LinesMatch(t, []string{"*"}, "a\nb")
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		LinesMatch(t, []string{"re:("}, "(")
+	})
+}
+
+func TestTextEqualIgnoringIndent(t *testing.T) {
+	TextEqualIgnoringIndent(t, "if x {\n\treturn\n}", "if x {\n    return\n}")
+	TextEqualIgnoringIndent(t, "  a\nb", "a\n    b")
+}
+
+func TestTextEqualIgnoringIndent_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		TextEqualIgnoringIndent(t, "a\nb", "a\nc")
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		TextEqualIgnoringIndent(t, "a b", "a  b")
+	})
+}
@@ -0,0 +1,152 @@
+package assert
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pterm/pterm"
+
+	"github.com/chalk-ai/assert/internal"
+)
+
+// WithinDuration asserts that two times are at most delta apart.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.WithinDuration(t, time.Now(), job.StartedAt, time.Second)
+func WithinDuration(t testRunner, expected, actual time.Time, delta time.Duration, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	if difference := actual.Sub(expected).Abs(); difference > delta {
+		internal.Fail(t, fmt.Sprintf("Two times !!are not within %s!! of each other, but should be.", delta),
+			append(timeObjects("Expected", expected, "Actual", actual), internal.NewObjectsSingleNamed("Allowed Delta", delta)[0]), msg...)
+	}
+}
+
+// TimeBefore asserts that a time is before a reference time.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.TimeBefore(t, job.StartedAt, job.FinishedAt)
+func TimeBefore(t testRunner, actual, reference time.Time, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	if !actual.Before(reference) {
+		internal.Fail(t, "A time that !!should be before!! the reference time is not.", timeObjects("Reference", reference, "Actual", actual), msg...)
+	}
+}
+
+// TimeAfter asserts that a time is after a reference time.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.TimeAfter(t, token.ExpiresAt, time.Now())
+func TimeAfter(t testRunner, actual, reference time.Time, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	if !actual.After(reference) {
+		internal.Fail(t, "A time that !!should be after!! the reference time is not.", timeObjects("Reference", reference, "Actual", actual), msg...)
+	}
+}
+
+// SameInstant asserts that two times describe the same instant.
+// Unlike Equal, the location and the monotonic clock reading are ignored.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.SameInstant(t, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC), parsed)
+func SameInstant(t testRunner, expected, actual time.Time, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	if !expected.Equal(actual) {
+		internal.Fail(t, "Two times !!are not the same instant!!, but should be.", timeObjects("Expected", expected, "Actual", actual), msg...)
+	}
+}
+
+// DurationInRange asserts that a duration is between lower and upper, inclusively.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.DurationInRange(t, backoff.Next(), 100*time.Millisecond, 200*time.Millisecond)
+func DurationInRange(t testRunner, d, lower, upper time.Duration, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	if lower > upper {
+		internal.Fail(t, "The minimum duration is greater than the maximum duration.", internal.Objects{
+			internal.NewObjectsSingleNamed("Min", lower)[0],
+			internal.NewObjectsSingleNamed("Max", upper)[0],
+		}, msg...)
+		return
+	}
+
+	if d < lower || d > upper {
+		internal.Fail(t, fmt.Sprintf("The duration !!is not between %s and %s!!, but should be.", lower, upper), internal.Objects{
+			internal.NewObjectsSingleNamed("Duration", d)[0],
+			internal.NewObjectsSingleNamed("Min", lower)[0],
+			internal.NewObjectsSingleNamed("Max", upper)[0],
+		}, msg...)
+	}
+}
+
+// TimeInLocation asserts that a time is in the expected location.
+// Locations are compared by name, so a location loaded with time.LoadLocation matches the same predefined location.
+//
+// When using a custom message, the same formatting as with fmt.Sprintf() is used.
+//
+// Example:
+//
+//	assert.TimeInLocation(t, event.At, time.UTC)
+func TimeInLocation(t testRunner, actual time.Time, location *time.Location, msg ...any) {
+	if test, ok := t.(helper); ok {
+		test.Helper()
+	}
+
+	if location == nil {
+		internal.Fail(t, "The expected location !!is nil!!.", internal.Objects{}, msg...)
+		return
+	}
+
+	if actual.Location().String() != location.String() {
+		internal.Fail(t, fmt.Sprintf("A time !!is not in the location %q!!, but in %q.", location, actual.Location()),
+			internal.NewObjectsSingleNamed("Time", actual), msg...)
+	}
+}
+
+// timeObjects returns two named times and the difference of the second to the first.
+func timeObjects(firstName string, first time.Time, secondName string, second time.Time) internal.Objects {
+	return internal.Objects{
+		{
+			Name:      firstName,
+			NameStyle: pterm.NewStyle(pterm.FgLightGreen),
+			Data:      first,
+			DataStyle: pterm.NewStyle(pterm.FgGreen),
+		},
+		{
+			Name:      secondName,
+			NameStyle: pterm.NewStyle(pterm.FgLightRed),
+			Data:      second,
+			DataStyle: pterm.NewStyle(pterm.FgRed),
+		},
+		internal.NewObjectsSingleNamed("Difference", second.Sub(first))[0],
+	}
+}
@@ -0,0 +1,89 @@
+package assert_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/chalk-ai/assert"
+)
+
+var testTime = time.Date(2024, 3, 10, 12, 30, 0, 0, time.UTC)
+
+func TestWithinDuration(t *testing.T) {
+	WithinDuration(t, testTime, testTime.Add(time.Second), time.Second)
+	WithinDuration(t, testTime, testTime.Add(-time.Second), time.Second)
+}
+
+func TestWithinDuration_fails(t *testing.T) {
+	var tm testMock
+	WithinDuration(&tm, testTime, testTime.Add(90*time.Second), time.Minute)
+
+	True(t, tm.ErrorCalled)
+	Contains(t, tm.ErrorMessage, "2024-03-10 12:30:00 +0000 UTC")
+	Contains(t, tm.ErrorMessage, "1m30s")
+	NotContains(t, tm.ErrorMessage, "wall")
+}
+
+func TestTimeBefore(t *testing.T) {
+	TimeBefore(t, testTime, testTime.Add(time.Nanosecond))
+}
+
+func TestTimeBefore_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		TimeBefore(t, testTime, testTime)
+	})
+}
+
+func TestTimeAfter(t *testing.T) {
+	TimeAfter(t, testTime.Add(time.Nanosecond), testTime)
+}
+
+func TestTimeAfter_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		TimeAfter(t, testTime.Add(-time.Hour), testTime)
+	})
+}
+
+func TestSameInstant(t *testing.T) {
+	berlin := time.FixedZone("CET", 60*60)
+	SameInstant(t, testTime, testTime.In(berlin))
+
+	now := time.Now()
+	SameInstant(t, now, now.Round(0))
+}
+
+func TestSameInstant_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		SameInstant(t, testTime, testTime.Add(time.Millisecond))
+	})
+}
+
+func TestDurationInRange(t *testing.T) {
+	DurationInRange(t, 150*time.Millisecond, 100*time.Millisecond, 200*time.Millisecond)
+	DurationInRange(t, time.Second, time.Second, time.Second)
+}
+
+func TestDurationInRange_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		DurationInRange(t, time.Second, 100*time.Millisecond, 200*time.Millisecond)
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		DurationInRange(t, time.Second, time.Minute, time.Second)
+	})
+}
+
+func TestTimeInLocation(t *testing.T) {
+	TimeInLocation(t, testTime, time.UTC)
+	TimeInLocation(t, testTime.In(time.FixedZone("CET", 60*60)), time.FixedZone("CET", 60*60))
+}
+
+func TestTimeInLocation_fails(t *testing.T) {
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		TimeInLocation(t, testTime, time.FixedZone("CET", 60*60))
+	})
+
+	TestFails(t, func(t TestingPackageWithFailFunctions) {
+		TimeInLocation(t, testTime, nil)
+	})
+}